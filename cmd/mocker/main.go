@@ -15,9 +15,20 @@ func init() {
 	kingpin.Arg("source-file", "Source file containing interfaces to generate mocks from.").StringVar(&c.Src)
 	kingpin.Arg("source-interfaces", "List of interface names to mock. Comma delimited.").StringsVar(&c.Itf)
 	kingpin.Flag("destination", "File to write generated mocks in. Default is stdout.").Short('d').StringVar(&c.Dst)
+	kingpin.Flag("dst-dir", "Directory to write one file of generated mocks per interface in, e.g. user_service_mock.go.").StringVar(&c.Dir)
 	kingpin.Flag("package", "Name of the mock's package. Inferred by default.").Short('p').StringVar(&c.Pkg)
 	kingpin.Flag("prefix", "Prefix to put in front of the generated interface mock names.").Short('P').Default("Mock").StringVar(&c.Pre)
 	kingpin.Flag("suffix", "Suffix to put at the enf of the generated interface mock names.").Short('S').StringVar(&c.Suf)
+	kingpin.Flag("order", "Order of the generated methods: source keeps the interface's order, alpha sorts them by name.").Default(mocker.OrderSource).EnumVar(&c.Ord, mocker.OrderSource, mocker.OrderAlpha)
+	kingpin.Flag("trace", "Record the caller's location, the time and the goroutine of each call.").BoolVar(&c.Trc)
+	kingpin.Flag("loose", "Make methods without a func record the call and return zero values, rather than panic.").BoolVar(&c.Lax)
+	kingpin.Flag("delegate", "Give mocks a Delegate of the interface's type that methods without a func call.").BoolVar(&c.Dlg)
+	kingpin.Flag("expect", "Generate methods setting expected calls, and asserting they were made.").BoolVar(&c.Exp)
+	kingpin.Flag("events", "Generate a Subscribe method publishing the calls made to mocks.").BoolVar(&c.Evt)
+	kingpin.Flag("build-tags", "Build constraint the generated file's built with, e.g. '!production'.").StringVar(&c.Tag)
+	kingpin.Flag("license", "File with a license header to start the generated file with.").StringVar(&c.Lic)
+	kingpin.Flag("comment", "Comment line to add to the generated file's header. Repeatable.").StringsVar(&c.Cmt)
+	kingpin.Flag("template", "text/template file to generate mocks with, in place of the builtin mocks.").StringVar(&c.Tpl)
 	kingpin.Flag("import-path", "The full package import path for the generated code. The purpose of this flag is to prevent import cycles in the generated code by trying to include its own package.").Short('s').StringVar(&c.Slf)
 
 	// to maintain backwards compatibility
@@ -0,0 +1,7 @@
+package mocker
+
+import "errors"
+
+// ErrNotStubbed is a convenient error for loose mocks to return from methods
+// that haven't been stubbed.
+var ErrNotStubbed = errors.New("mocker: method not stubbed")
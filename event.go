@@ -0,0 +1,149 @@
+package mocker
+
+import "sync"
+
+// CallEvent is published by mocks generated with events for each call made to
+// them, once it's returned or panicked.
+type CallEvent struct {
+	// Mock is the name of the mock's type.
+	Mock string
+	// Method is the name of the method called.
+	Method string
+	// Args are the args of the call, with variadic args as a slice.
+	Args []interface{}
+	// Results are what the call returned.
+	Results []interface{}
+	// Panic is the value the call panicked with, if it did.
+	Panic interface{}
+}
+
+// Events publishes a mock's calls to its subscribers. The zero value has no
+// subscribers and is ready to use.
+type Events struct {
+	mu   sync.Mutex
+	subs []*subscriber
+}
+
+// Subscribe returns a channel receiving the calls published from now on, and
+// a func unsubscribing it. Calls are queued for each subscriber, so publishing
+// never blocks on a slow subscriber. Once unsubscribed, the channel's closed
+// and its queued calls are dropped.
+func (es *Events) Subscribe() (<-chan CallEvent, func()) {
+	s := &subscriber{ch: make(chan CallEvent), done: make(chan struct{})}
+	es.mu.Lock()
+	es.subs = append(es.subs, s)
+	es.mu.Unlock()
+
+	return s.ch, func() {
+		es.remove(s)
+		s.close()
+	}
+}
+
+// Close unsubscribes the subscribers, so calls queued for subscribers that
+// stopped receiving don't keep their goroutines around.
+func (es *Events) Close() {
+	es.mu.Lock()
+	subs := es.subs
+	es.subs = nil
+	es.mu.Unlock()
+
+	for _, s := range subs {
+		s.close()
+	}
+}
+
+// remove removes s from the subscribers.
+func (es *Events) remove(s *subscriber) {
+	es.mu.Lock()
+	defer es.mu.Unlock()
+
+	for i, sub := range es.subs {
+		if sub == s {
+			es.subs = append(es.subs[:i:i], es.subs[i+1:]...)
+			return
+		}
+	}
+}
+
+// Publish publishes e to the subscribers.
+func (es *Events) Publish(e CallEvent) {
+	es.mu.Lock()
+	subs := make([]*subscriber, len(es.subs))
+	copy(subs, es.subs)
+	es.mu.Unlock()
+
+	for _, s := range subs {
+		s.push(e)
+	}
+}
+
+type subscriber struct {
+	ch      chan CallEvent
+	done    chan struct{}
+	mu      sync.Mutex
+	queue   []CallEvent
+	sending bool
+	closed  bool
+}
+
+// close stops sending to the subscriber and closes its channel, or leaves
+// that to the goroutine sending to it, if there is one.
+func (s *subscriber) close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return
+	}
+	s.closed = true
+	close(s.done)
+	if !s.sending {
+		close(s.ch)
+	}
+}
+
+// push queues e and starts sending the queue to the subscriber unless it's
+// being sent already, or it's closed.
+func (s *subscriber) push(e CallEvent) {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return
+	}
+	s.queue = append(s.queue, e)
+	if s.sending {
+		s.mu.Unlock()
+		return
+	}
+	s.sending = true
+	s.mu.Unlock()
+
+	go s.send()
+}
+
+func (s *subscriber) send() {
+	for {
+		s.mu.Lock()
+		if s.closed {
+			s.queue = nil
+			s.sending = false
+			close(s.ch)
+			s.mu.Unlock()
+			return
+		}
+		if len(s.queue) == 0 {
+			s.sending = false
+			s.mu.Unlock()
+			return
+		}
+		e := s.queue[0]
+		s.queue = s.queue[1:]
+		s.mu.Unlock()
+
+		select {
+		case s.ch <- e:
+		case <-s.done:
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package mocker
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/travisjeffery/mocker/pkg/mocker/match"
+)
+
+// T is the part of testing.TB mocks report failures through.
+type T interface {
+	Helper()
+	Errorf(format string, args ...interface{})
+}
+
+// Expectations are the calls expected of a mock. The zero value has no
+// expectations and is ready to use.
+type Expectations struct {
+	mu         sync.Mutex
+	expected   []*Expectation
+	unexpected []string
+}
+
+// Expectation is a call expected of a mock: the method, the args it's
+// expected with, what it returns and how many times it's expected. Args
+// that are match.Matchers match the values they match, others match equal
+// values.
+type Expectation struct {
+	es     *Expectations
+	method string
+	args   []interface{}
+	rets   []interface{}
+	times  int
+	calls  int
+}
+
+// Expect adds an expectation of a call to method with args, expected once.
+func (es *Expectations) Expect(method string, args ...interface{}) *Expectation {
+	es.mu.Lock()
+	defer es.mu.Unlock()
+
+	e := &Expectation{
+		es:     es,
+		method: method,
+		args:   args,
+		times:  1,
+	}
+	es.expected = append(es.expected, e)
+	return e
+}
+
+// Call records a call to method with args and returns the expectation it
+// meets. If method has expectations but the call meets none of them, either
+// because its args don't match or the matching ones have been met already,
+// the call is recorded as unexpected and Call returns nil. Calls to methods
+// without expectations are always allowed and Call returns nil.
+func (es *Expectations) Call(method string, args ...interface{}) *Expectation {
+	es.mu.Lock()
+	defer es.mu.Unlock()
+
+	var expected bool
+	for _, e := range es.expected {
+		if e.method != method {
+			continue
+		}
+		expected = true
+		if e.calls < e.times && e.matches(args) {
+			e.calls++
+			return e
+		}
+	}
+	if expected {
+		es.unexpected = append(es.unexpected, Describe(method, args...))
+	}
+	return nil
+}
+
+// Assert reports through t any expected calls that weren't made as many
+// times as expected, and any unexpected calls. It returns whether there were
+// none.
+func (es *Expectations) Assert(t T) bool {
+	t.Helper()
+	es.mu.Lock()
+	defer es.mu.Unlock()
+
+	ok := true
+	for _, e := range es.expected {
+		if e.calls != e.times {
+			t.Errorf("mocker: expected %v %v times, got %v", Describe(e.method, e.args...), e.times, e.calls)
+			ok = false
+		}
+	}
+	for _, call := range es.unexpected {
+		t.Errorf("mocker: unexpected call to %v", call)
+		ok = false
+	}
+	return ok
+}
+
+// Times sets how many times the call's expected.
+func (e *Expectation) Times(n int) {
+	e.es.mu.Lock()
+	defer e.es.mu.Unlock()
+
+	e.times = n
+}
+
+// Returns sets what the expected call returns.
+func (e *Expectation) Returns(rets ...interface{}) {
+	e.es.mu.Lock()
+	defer e.es.mu.Unlock()
+
+	e.rets = rets
+}
+
+// Result returns the i'th result of the expected call, or nil if it's unset.
+func (e *Expectation) Result(i int) interface{} {
+	e.es.mu.Lock()
+	defer e.es.mu.Unlock()
+
+	if i >= len(e.rets) {
+		return nil
+	}
+	return e.rets[i]
+}
+
+func (e *Expectation) matches(args []interface{}) bool {
+	if len(args) != len(e.args) {
+		return false
+	}
+	for i, arg := range args {
+		if !match.Of(e.args[i]).Match(arg) {
+			return false
+		}
+	}
+	return true
+}
+
+// Describe describes a call to method with args, which may be match.Matchers.
+func Describe(method string, args ...interface{}) string {
+	s := make([]string, len(args))
+	for i, arg := range args {
+		s[i] = match.Of(arg).String()
+	}
+	return method + "(" + strings.Join(s, ", ") + ")"
+}
@@ -0,0 +1,55 @@
+package mocker
+
+import "sync/atomic"
+
+// seq is the sequence number of the last call made to any mock.
+var seq uint64
+
+// Sequence returns the next sequence number, which mocks record with each call
+// so the order of calls can be asserted on across methods and mocks.
+func Sequence() uint64 {
+	return atomic.AddUint64(&seq, 1)
+}
+
+// Call describes calls made to a mock, for asserting on their order with
+// InOrder. Generated mocks have a method returning them for each mocked
+// method, e.g. GetCall for Get.
+type Call struct {
+	// Desc describes the calls.
+	Desc string
+	// Seqs are the sequence numbers of the calls, in the order they were made.
+	Seqs []uint64
+}
+
+// InOrder reports through t unless calls were made in the order given, each
+// after the one before it, and returns whether they were. Each Call only needs
+// one of its calls to fit the order.
+func InOrder(t T, calls ...Call) bool {
+	t.Helper()
+
+	var last uint64
+	var prev string
+	for _, call := range calls {
+		next, ok := after(call.Seqs, last)
+		if !ok {
+			if prev == "" {
+				t.Errorf("mocker: %v wasn't called", call.Desc)
+			} else {
+				t.Errorf("mocker: %v wasn't called after %v", call.Desc, prev)
+			}
+			return false
+		}
+		last, prev = next, call.Desc
+	}
+	return true
+}
+
+// after returns the first of seqs after last.
+func after(seqs []uint64, last uint64) (uint64, bool) {
+	for _, s := range seqs {
+		if s > last {
+			return s, true
+		}
+	}
+	return 0, false
+}
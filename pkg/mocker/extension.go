@@ -0,0 +1,61 @@
+package mocker
+
+import "github.com/golang/mock/mockgen/model"
+
+// Extension adds to the mocks a Generator generates, e.g. to ship house
+// helpers in a custom mocker binary calling Run with them in Config.Ext. Its
+// hooks write code with the Generator's P, In and Out, and are skipped if
+// nil.
+type Extension struct {
+	// Fields is called after the fields of mock's struct, to add fields.
+	Fields func(g *Generator, mock string, intf *model.Interface) error
+	// Method is called after each of mock's methods and its helpers, to add
+	// helpers.
+	Method func(g *Generator, mock string, m *model.Method) error
+	// Reset is called after mock's Reset method, to add methods.
+	Reset func(g *Generator, mock string, intf *model.Interface) error
+	// Decls is called after the mocks, to add file level declarations.
+	Decls func(g *Generator) error
+}
+
+// P writes a line formatted like fmt.Sprintf at the current indentation.
+func (g *Generator) P(format string, args ...interface{}) {
+	g.p(format, args...)
+}
+
+// In indents the lines written after it one more level.
+func (g *Generator) In() {
+	g.in()
+}
+
+// Out indents the lines written after it one less level.
+func (g *Generator) Out() {
+	g.out()
+}
+
+// Type returns t as written in the generated file.
+func (g *Generator) Type(t model.Type) string {
+	return t.String(g.imports, g.c.Slf)
+}
+
+// Qualify returns name qualified with the package at path, importing it.
+func (g *Generator) Qualify(path, name string) string {
+	if path == g.c.Slf {
+		return name
+	}
+	if _, ok := g.imports[path]; !ok {
+		g.imports[path] = g.scope.allocateIdentifier(g.importName(path))
+	}
+	return g.imports[path] + "." + name
+}
+
+// Ident returns name, suffixed if it's taken in the file, which it then is.
+func (g *Generator) Ident(name string) string {
+	return g.scope.allocateIdentifier(name)
+}
+
+// NewScope returns a scope to allocate identifiers in that don't collide with
+// the file's, e.g. for a method's params and locals.
+func (g *Generator) NewScope() *Scope {
+	return &Scope{a: newIdentifierAllocator(g.scope)}
+}
@@ -0,0 +1,206 @@
+// Package match provides matchers for asserting on the args of calls made to
+// mocks generated by mocker.
+package match
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+// Matcher matches the value of an arg.
+type Matcher interface {
+	// Match returns whether v matches.
+	Match(v interface{}) bool
+	// String describes what the matcher matches.
+	String() string
+}
+
+// Of returns v if it's a Matcher, else a Matcher of values equal to v.
+func Of(v interface{}) Matcher {
+	if m, ok := v.(Matcher); ok {
+		return m
+	}
+	return Eq(v)
+}
+
+// Any matches any value.
+func Any() Matcher {
+	return anyMatcher{}
+}
+
+type anyMatcher struct{}
+
+func (anyMatcher) Match(v interface{}) bool {
+	return true
+}
+
+func (anyMatcher) String() string {
+	return "any"
+}
+
+// Eq matches values deeply equal to want. Since args have been through
+// interface{}, want is converted to the value's type if it's of the same kind,
+// so untyped constants match named types, and a nil want matches any nil
+// value.
+func Eq(want interface{}) Matcher {
+	return eqMatcher{want: want}
+}
+
+type eqMatcher struct {
+	want interface{}
+}
+
+func (m eqMatcher) Match(v interface{}) bool {
+	want := m.want
+	if want == nil || v == nil {
+		return isNil(want) && isNil(v)
+	}
+	wv, vv := reflect.ValueOf(want), reflect.ValueOf(v)
+	if wv.Kind() == vv.Kind() && wv.Type().ConvertibleTo(vv.Type()) {
+		want = wv.Convert(vv.Type()).Interface()
+	}
+	return reflect.DeepEqual(want, v)
+}
+
+func (m eqMatcher) String() string {
+	if s, ok := m.want.(string); ok {
+		return fmt.Sprintf("%q", s)
+	}
+	return fmt.Sprint(m.want)
+}
+
+func isNil(v interface{}) bool {
+	if v == nil {
+		return true
+	}
+	rv := reflect.ValueOf(v)
+	return isNilable(rv.Kind()) && rv.IsNil()
+}
+
+// Regexp matches strings, byte slices and fmt.Stringers matching the regular
+// expression pattern. It panics if pattern doesn't compile.
+func Regexp(pattern string) Matcher {
+	return regexpMatcher{re: regexp.MustCompile(pattern)}
+}
+
+type regexpMatcher struct {
+	re *regexp.Regexp
+}
+
+func (m regexpMatcher) Match(v interface{}) bool {
+	switch v := v.(type) {
+	case string:
+		return m.re.MatchString(v)
+	case []byte:
+		return m.re.Match(v)
+	case fmt.Stringer:
+		return m.re.MatchString(v.String())
+	}
+	return false
+}
+
+func (m regexpMatcher) String() string {
+	return fmt.Sprintf("matching /%v/", m.re)
+}
+
+// Contains matches strings containing the substring elem, and slices, arrays
+// and maps containing a value, or a key for maps, matching elem, which may be
+// a Matcher.
+func Contains(elem interface{}) Matcher {
+	return containsMatcher{elem: elem}
+}
+
+type containsMatcher struct {
+	elem interface{}
+}
+
+func (m containsMatcher) Match(v interface{}) bool {
+	if s, ok := v.(string); ok {
+		sub, ok := m.elem.(string)
+		return ok && strings.Contains(s, sub)
+	}
+	elem := Of(m.elem)
+	switch rv := reflect.ValueOf(v); rv.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < rv.Len(); i++ {
+			if elem.Match(rv.Index(i).Interface()) {
+				return true
+			}
+		}
+	case reflect.Map:
+		for _, k := range rv.MapKeys() {
+			if elem.Match(k.Interface()) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (m containsMatcher) String() string {
+	return fmt.Sprintf("containing %v", Of(m.elem))
+}
+
+// Func matches values the predicate returns true for. The predicate must be a
+// func taking one arg and returning a bool, and only matches values assignable
+// to its arg; Func panics otherwise.
+func Func(predicate interface{}) Matcher {
+	fv := reflect.ValueOf(predicate)
+	ft := fv.Type()
+	if ft.Kind() != reflect.Func || ft.NumIn() != 1 || ft.NumOut() != 1 || ft.Out(0).Kind() != reflect.Bool {
+		panic(fmt.Sprintf("match: Func predicate must be a func(T) bool, got %v", ft))
+	}
+	return funcMatcher{fn: fv}
+}
+
+type funcMatcher struct {
+	fn reflect.Value
+}
+
+func (m funcMatcher) Match(v interface{}) bool {
+	in := m.fn.Type().In(0)
+	var arg reflect.Value
+	switch {
+	case v == nil:
+		if !isNilable(in.Kind()) {
+			return false
+		}
+		arg = reflect.Zero(in)
+	case reflect.TypeOf(v).AssignableTo(in):
+		arg = reflect.ValueOf(v)
+	default:
+		return false
+	}
+	return m.fn.Call([]reflect.Value{arg})[0].Bool()
+}
+
+func (m funcMatcher) String() string {
+	return fmt.Sprintf("satisfying %v", m.fn.Type())
+}
+
+func isNilable(k reflect.Kind) bool {
+	switch k {
+	case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Ptr, reflect.Slice:
+		return true
+	}
+	return false
+}
+
+// Context matches any non-nil context.Context.
+func Context() Matcher {
+	return contextMatcher{}
+}
+
+type contextMatcher struct{}
+
+func (contextMatcher) Match(v interface{}) bool {
+	_, ok := v.(context.Context)
+	return ok
+}
+
+func (contextMatcher) String() string {
+	return "any context.Context"
+}
@@ -3,6 +3,7 @@ package mocker
 import (
 	"bytes"
 	"fmt"
+	"go/build/constraint"
 	"go/token"
 	"log"
 	"os"
@@ -10,6 +11,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"text/template"
 	"unicode"
 
 	"github.com/golang/mock/mockgen/model"
@@ -19,14 +21,71 @@ import (
 type Config struct {
 	Src string
 	Dst string
+	Dir string
 	Pre string
 	Suf string
 	Pkg string
 	Slf string
+	Ord string
+	Trc bool
+	Lax bool
+	Dlg bool
+	Exp bool
+	Evt bool
+	Tag string
+	Lic string
+	Cmt []string
+	Tpl string
+	Ext []Extension
 	Itf []string
 }
 
+// Import paths of the packages generated mocks call into.
+const (
+	runtimePath = "github.com/travisjeffery/mocker"
+	matchPath   = "github.com/travisjeffery/mocker/pkg/mocker/match"
+)
+
+// Method orderings for the generated output.
+const (
+	OrderSource = "source"
+	OrderAlpha  = "alpha"
+)
+
 func Run(c Config) error {
+	switch c.Ord {
+	case "", OrderSource, OrderAlpha:
+	default:
+		return fmt.Errorf("unknown method order %q", c.Ord)
+	}
+
+	if c.Tag != "" {
+		if _, err := constraint.Parse("//go:build " + c.Tag); err != nil {
+			return fmt.Errorf("invalid build constraint %q: %v", c.Tag, err)
+		}
+	}
+
+	var license string
+	if c.Lic != "" {
+		b, err := os.ReadFile(c.Lic)
+		if err != nil {
+			return fmt.Errorf("failed reading license header: %v", err)
+		}
+		license = string(b)
+	}
+
+	var tmpl *template.Template
+	if c.Tpl != "" {
+		b, err := os.ReadFile(c.Tpl)
+		if err != nil {
+			return fmt.Errorf("failed reading template: %v", err)
+		}
+		tmpl, err = ParseTemplate(filepath.Base(c.Tpl), string(b))
+		if err != nil {
+			return fmt.Errorf("failed parsing template: %v", err)
+		}
+	}
+
 	pkg, err := ParseFile(c.Src)
 	if err != nil {
 		return err
@@ -37,6 +96,33 @@ func Run(c Config) error {
 		c.Pkg = pkg.Name
 	}
 
+	if c.Dir == "" {
+		return generate(c, pkg, license, tmpl)
+	}
+	if c.Dst != "" {
+		return fmt.Errorf("destination and destination directory are mutually exclusive")
+	}
+	for _, intf := range pkg.Interfaces {
+		if !contains(c.Itf, intf.Name) {
+			continue
+		}
+		ic := c
+		ic.Itf = []string{intf.Name}
+		ic.Dst = filepath.Join(c.Dir, mockFileName(intf.Name))
+		if err := generate(ic, pkg, license, tmpl); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// generate writes the mocks of c.Itf to c.Dst, or stdout if it's unset.
+func generate(c Config, pkg *Package, license string, tmpl *template.Template) error {
+	if c.Slf == "" && c.Dst != "" && c.Pkg == pkg.Name && sameDir(c.Src, c.Dst) {
+		// the mock's in the source package, so mustn't import it.
+		c.Slf = pkg.PkgPath
+	}
+
 	dst := os.Stdout
 	if len(c.Dst) > 0 {
 		if err := os.MkdirAll(filepath.Dir(c.Dst), os.ModePerm); err != nil {
@@ -51,24 +137,31 @@ func Run(c Config) error {
 	}
 
 	g := &Generator{
-		c:   c,
-		pkg: pkg,
+		c:       c,
+		pkg:     pkg,
+		license: license,
+		tmpl:    tmpl,
 	}
 
 	if err := g.Generate(); err != nil {
-		return nil
+		return err
 	}
 
-	_, err = dst.Write(g.Output())
+	_, err := dst.Write(g.Output())
 	return err
 }
 
 type Generator struct {
 	c       Config
 	pkg     *Package
+	license string             // header the output starts with
+	tmpl    *template.Template // generates the mocks in place of the builtin ones, if set
 	buf     bytes.Buffer
-	imports map[string]string // import path to pkg name
-	types   map[string]string // interface type name to name used in generated code
+	imports map[string]string    // import path to pkg name
+	names   map[string]string    // import path to the package's own name, where it's known
+	types   map[string]string    // file level names the mocks want to the names they got
+	scope   *identifierAllocator // file level identifiers, parent of each method's scope
+	members map[string]string    // the current mock's fields and methods the generator adds to the names they got
 	indent  string
 }
 
@@ -88,63 +181,223 @@ func (g *Generator) Output() []byte {
 }
 
 func (g *Generator) Generate() error {
+	if g.license != "" {
+		g.generateLicense()
+		g.p("")
+	}
+	if g.c.Tag != "" {
+		expr, err := constraint.Parse("//go:build " + g.c.Tag)
+		if err != nil {
+			return fmt.Errorf("invalid build constraint %q: %v", g.c.Tag, err)
+		}
+		g.p("//go:build %v", expr)
+		// Go before 1.17 only reads +build lines.
+		lines, err := constraint.PlusBuildLines(expr)
+		if err != nil {
+			return fmt.Errorf("invalid build constraint %q: %v", g.c.Tag, err)
+		}
+		for _, line := range lines {
+			g.p("%v", line)
+		}
+		g.p("")
+	}
 	g.p("// Code generated by mocker. DO NOT EDIT.")
 	g.p("// github.com/travisjeffery/mocker")
 	if g.c.Src != "" {
 		g.p("// Source: %v", g.c.Src)
 	}
+	for _, line := range g.c.Cmt {
+		g.p("// %v", line)
+	}
 	g.p("")
 
-	g.setupImports()
+	if err := g.setupImports(); err != nil {
+		return err
+	}
+
+	// the mocks are generated before the imports, so templates and extensions
+	// can add to them.
+	header := append([]byte(nil), g.buf.Bytes()...)
+	g.buf.Reset()
+	if err := g.generateMocks(); err != nil {
+		return err
+	}
+	body := append([]byte(nil), g.buf.Bytes()...)
+	g.buf.Reset()
 
+	g.buf.Write(header)
 	g.p("package %v", g.c.Pkg)
 	g.p("")
-
 	g.GenerateImports()
-	for _, intf := range g.pkg.Interfaces {
-		if !contains(g.c.Itf, intf.Name) {
+	g.buf.Write(body)
+	return nil
+}
+
+func (g *Generator) generateMocks() error {
+	if g.tmpl != nil {
+		g.p("")
+		if err := g.executeTemplate(); err != nil {
+			return err
+		}
+	} else {
+		for _, intf := range g.interfaces() {
+			if err := g.GenerateInterface(intf); err != nil {
+				return err
+			}
+		}
+	}
+	for _, ext := range g.c.Ext {
+		if ext.Decls == nil {
 			continue
 		}
-		if err := g.GenerateInterface(intf); err != nil {
+		g.p("")
+		if err := ext.Decls(g); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func (g *Generator) setupImports() {
-	imports := g.pkg.Imports()
-	sortedPaths := make([]string, len(imports))
-	sortedPaths = append(sortedPaths, "sync")
-	i := 0
+// generateLicense generates the license header, commenting out its lines
+// unless it's a comment already.
+func (g *Generator) generateLicense() {
+	license := strings.TrimRight(g.license, "\n")
+	if strings.HasPrefix(license, "//") || strings.HasPrefix(license, "/*") {
+		g.p("%s", license)
+		return
+	}
+	for _, line := range strings.Split(license, "\n") {
+		g.p("%s", strings.TrimRight("// "+line, " "))
+	}
+}
+
+func (g *Generator) setupImports() error {
+	// only what the mocked interfaces use, so each file's imports are minimal.
+	imports := (&model.Package{Interfaces: g.interfaces()}).Imports()
+	imports["sync"] = true
+	imports["context"] = true
+	imports["testing"] = true
+	imports[matchPath] = true
+	imports[runtimePath] = true
+	if g.c.Trc {
+		imports["time"] = true
+	}
+	if g.c.Dlg {
+		imports[g.pkg.PkgPath] = true
+	}
+	sortedPaths := make([]string, 0, len(imports))
 	for path := range imports {
-		sortedPaths[i] = path
-		i++
+		sortedPaths = append(sortedPaths, path)
 	}
 	sort.Strings(sortedPaths)
+	g.names = packageNames(filepath.Dir(g.c.Src), sortedPaths)
+	g.names[g.pkg.PkgPath] = g.pkg.Name
+	// in the source package, its own declarations are taken too, so imports
+	// colliding with them get the suffix.
+	var decls []string
+	if g.c.Slf == g.pkg.PkgPath {
+		var err error
+		decls, err = packageDecls(filepath.Dir(g.c.Src), g.pkg.Name, g.c.Dst)
+		if err != nil {
+			return fmt.Errorf("failed parsing source package: %v", err)
+		}
+	}
+	g.scope = newIdentifierAllocator(universe, decls...)
+	// then the mocks' declarations, so they keep their names and any import
+	// they collide with gets the suffix.
+	g.types = make(map[string]string)
+	for _, intf := range g.interfaces() {
+		mockType := g.declare(g.c.Pre + intf.Name + g.c.Suf)
+		g.declare("New" + mockType)
+		for _, m := range intf.Methods {
+			g.declare(funcTypeName(mockType, m))
+			g.declare(callTypeName(mockType, m))
+			if g.c.Exp {
+				g.declare(expectationTypeName(mockType, m))
+			}
+		}
+	}
 	g.imports = make(map[string]string, len(imports))
-	names := make(map[string]bool, len(imports))
+	// then the source file's names, so they're kept and any other import they
+	// collide with gets the suffix.
 	for _, path := range sortedPaths {
-		base := sanitize(path)
-		pkgName := base
-		i := 0
-		for names[pkgName] || token.Lookup(pkgName).IsKeyword() {
-			pkgName = base + strconv.Itoa(i)
-			i++
+		if name, ok := g.pkg.Names[path]; ok {
+			g.imports[path] = g.scope.allocateIdentifier(name)
 		}
-		g.imports[path] = pkgName
-		names[pkgName] = true
 	}
+	for _, path := range sortedPaths {
+		if _, ok := g.imports[path]; !ok {
+			g.imports[path] = g.scope.allocateIdentifier(g.importName(path))
+		}
+	}
+	return nil
+}
+
+// declare allocates the file level name want, recording the name it got.
+func (g *Generator) declare(want string) string {
+	name := g.scope.allocateIdentifier(want)
+	g.types[want] = name
+	return name
+}
+
+// declared returns the name the file level name want got.
+func (g *Generator) declared(want string) string {
+	if name, ok := g.types[want]; ok {
+		return name
+	}
+	return want
+}
+
+// importName returns the name to import the package at path as, absent a
+// name from the source file.
+func (g *Generator) importName(path string) string {
+	switch path {
+	case g.pkg.PkgPath:
+		return g.pkg.Name
+	case runtimePath:
+		return "mocker"
+	}
+	elems := strings.Split(path, "/")
+	last := elems[len(elems)-1]
+	if len(elems) > 1 && isMajorVersion(last) {
+		// e.g. example.com/foo/v2 is package foo.
+		last = elems[len(elems)-2]
+	}
+	// e.g. gopkg.in/yaml.v2 is package yaml.
+	return sanitize(strings.SplitN(last, ".", 2)[0])
 }
 
+// GenerateImports generates the imports sorted by path, standard library
+// first, naming them unless their name's the package's own. The path's last
+// element isn't enough, as it needn't be the package's name.
 func (g *Generator) GenerateImports() {
+	var std, other []string
+	for path := range g.imports {
+		switch {
+		case path == g.c.Slf:
+		case strings.Contains(strings.SplitN(path, "/", 2)[0], "."):
+			other = append(other, path)
+		default:
+			std = append(std, path)
+		}
+	}
+	sort.Strings(std)
+	sort.Strings(other)
+
 	g.p("import (")
 	g.in()
-	for path, pkg := range g.imports {
-		if path == g.c.Slf {
-			continue
+	for i, paths := range [][]string{std, other} {
+		if i > 0 && len(std) > 0 && len(other) > 0 {
+			g.p("")
+		}
+		for _, path := range paths {
+			name := g.imports[path]
+			if own, ok := g.names[path]; ok && name == own {
+				g.p("%q", path)
+			} else {
+				g.p("%v %q", name, path)
+			}
 		}
-		g.p("%v %q", pkg, path)
 	}
 	g.out()
 	g.p(")")
@@ -152,282 +405,1206 @@ func (g *Generator) GenerateImports() {
 
 func (g *Generator) GenerateInterface(intf *model.Interface) error {
 	mockType := g.typeName(intf.Name)
+	methods := g.methods(intf)
+	if err := g.allocateMembers(mockType, methods); err != nil {
+		return err
+	}
 
 	g.p("")
 	g.p("// %v is a mock of %v interface", mockType, intf.Name)
 	g.p("type %v struct {", mockType)
 	g.in()
 
-	for _, m := range intf.Methods {
-		g.p("lock%v sync.Mutex", m.Name)
-
-		argNames := g.getArgNames(m)
-		argTypes := g.getArgTypes(m)
-		argString := makeArgString(argNames, argTypes)
+	if g.c.Lax {
+		g.p("// %v makes calling a method without a func panic, rather than", g.member("Strict"))
+		g.p("// return zero values.")
+		g.p("%v bool", g.member("Strict"))
+		g.p("// %v is returned as the error result of methods without a", g.member("NotStubbedErr"))
+		g.p("// func.")
+		g.p("%v error", g.member("NotStubbedErr"))
+		g.p("")
+	}
 
-		rets := make([]string, len(m.Out))
-		for i, p := range m.Out {
-			rets[i] = p.Type.String(g.imports, g.c.Slf)
-		}
-		retString := strings.Join(rets, ", ")
-		if len(rets) > 1 {
-			retString = "(" + retString + ")"
-		}
-		if retString != "" {
-			retString = " " + retString
-		}
+	if g.c.Dlg {
+		intfType := &model.NamedType{Package: g.pkg.PkgPath, Type: intf.Name}
+		g.p("// %v is called by methods without a func, so the mock can spy on a", g.member("Delegate"))
+		g.p("// real implementation.")
+		g.p("%v %v", g.member("Delegate"), intfType.String(g.imports, g.c.Slf))
+		g.p("")
+	}
 
-		g.p("%vFunc func(%v) %v", m.Name, argString, retString)
+	for _, m := range methods {
+		g.p("lock%v %v.Mutex", m.Name, g.imports["sync"])
+		g.p("%v %v", g.methodMember("%vFunc", m), g.declared(funcTypeName(mockType, m)))
 		g.p("")
 	}
 
 	g.p("calls struct {")
 	g.in()
-	for _, m := range intf.Methods {
-		g.p("%v []struct {", m.Name)
-		g.in()
-
-		argNames := g.getArgNames(m)
-		argTypes := g.getArgTypes(m)
-
-		for i, name := range argNames {
-			s := fmt.Sprintf("%v %v", strings.Title(name), argTypes[i])
-			s = strings.Replace(s, "...", "[]", -1)
-			g.p(s)
-		}
-
-		g.out()
-		g.p("}")
+	for _, m := range methods {
+		g.p("%v []*%v", m.Name, g.declared(callTypeName(mockType, m)))
 	}
 	g.out()
 	g.p("}")
 
+	g.p("seqs struct {")
+	g.in()
+	for _, m := range methods {
+		g.p("%v []uint64", m.Name)
+	}
 	g.out()
 	g.p("}")
-	g.p("")
-
-	return g.GenerateMethods(mockType, intf)
-}
-
-func (g *Generator) GenerateMethods(mockType string, intf *model.Interface) error {
-	for _, m := range intf.Methods {
-		g.p("")
-		if err := g.GenerateMethod(mockType, m); err != nil {
-			return err
-		}
-		g.p("")
-	}
 
-	g.p("// Reset resets the calls made to the mocked methods.")
-	g.p("func (m *%v) Reset() {", mockType)
+	g.p("conds struct {")
 	g.in()
-	for _, m := range intf.Methods {
-		g.p("m.lock%v.Lock()", m.Name)
-		g.p("m.calls.%v = nil", m.Name)
-		g.p("m.lock%v.Unlock()", m.Name)
+	for _, m := range methods {
+		g.p("%v *%v.Cond", m.Name, g.imports["sync"])
 	}
 	g.out()
 	g.p("}")
 
-	return nil
-}
-
-func (g *Generator) GenerateMethod(mockType string, m *model.Method) error {
-	argNames := g.getArgNames(m)
-	argTypes := g.getArgTypes(m)
-	argString := makeArgString(argNames, argTypes)
-
-	rets := make([]string, len(m.Out))
-	for i, p := range m.Out {
-		rets[i] = p.Type.String(g.imports, g.c.Slf)
-	}
-	retString := strings.Join(rets, ", ")
-	if len(rets) > 1 {
-		retString = "(" + retString + ")"
-	}
-	if retString != "" {
-		retString = " " + retString
-	}
-
-	ia := newIdentifierAllocator(argNames)
-	idRecv := ia.allocateIdentifier("m")
-
-	g.p("// %v mocks base method by wrapping the associated func.", m.Name)
-	g.p("func (%v *%v) %v(%v)%v {", idRecv, mockType, m.Name, argString, retString)
-	g.in()
-	g.p("%s.lock%s.Lock()", idRecv, m.Name)
-	g.p("defer %s.lock%s.Unlock()", idRecv, m.Name)
-
-	g.p("")
-	g.p("if %v.%vFunc == nil {", idRecv, m.Name)
+	g.p("onCalls struct {")
 	g.in()
-	g.p("panic(\"mocker: %v.%vFunc is nil but %v.%v was called.\")", mockType, m.Name, mockType, m.Name)
+	for _, m := range methods {
+		g.p("%v map[int]%v", m.Name, g.declared(funcTypeName(mockType, m)))
+	}
 	g.out()
 	g.p("}")
-	g.p("")
 
-	g.p("call := struct {")
+	g.p("queued struct {")
 	g.in()
-	for i, name := range argNames {
-		s := fmt.Sprintf("%v %v", strings.Title(name), argTypes[i])
-		s = strings.Replace(s, "...", "[]", -1)
-		g.p(s)
+	for _, m := range methods {
+		g.p("%v []%v", m.Name, g.declared(funcTypeName(mockType, m)))
 	}
 	g.out()
-	g.p("}{")
+	g.p("}")
+
+	g.p("unstubbed struct {")
 	g.in()
-	for _, name := range argNames {
-		g.p("%v: %v,", strings.Title(name), name)
+	for _, m := range methods {
+		g.p("%v int", m.Name)
 	}
 	g.out()
 	g.p("}")
-	g.p("")
-
-	g.p("%v.calls.%v = append(%v.calls.%v, call)", idRecv, m.Name, idRecv, m.Name)
-	g.p("")
 
-	var callArgs string
-	if len(argNames) > 0 {
-		callArgs = strings.Join(argNames, ", ")
+	if g.c.Exp {
+		g.p("expectations %v.Expectations", g.imports[runtimePath])
 	}
 
-	if m.Variadic != nil {
-		callArgs += "..."
+	if g.c.Evt {
+		g.p("events %v.Events", g.imports[runtimePath])
 	}
 
-	if len(m.Out) == 0 {
-		g.p(`%v.%vFunc(%v)`, idRecv, m.Name, callArgs)
-	} else {
-		g.p(`return %v.%vFunc(%v)`, idRecv, m.Name, callArgs)
+	g.p("t %v.TB", g.imports["testing"])
+
+	for _, ext := range g.c.Ext {
+		if ext.Fields == nil {
+			continue
+		}
+		if err := ext.Fields(g, mockType, intf); err != nil {
+			return err
+		}
 	}
 
 	g.out()
 	g.p("}")
 	g.p("")
 
-	g.p("// %vCalled returns true if %v was called at least once.", m.Name, m.Name)
-	g.p("func (%v *%v) %vCalled() bool {", idRecv, mockType, m.Name)
-	g.in()
-	g.p("%s.lock%s.Lock()", idRecv, m.Name)
-	g.p("defer %s.lock%s.Unlock()", idRecv, m.Name)
-	g.p("")
-	g.p("return len(%v.calls.%v) > 0", idRecv, m.Name)
-	g.out()
-	g.p("}")
-
-	g.p("// %vCalls returns the calls made to %v.", m.Name, m.Name)
-	g.p("func (%v *%v) %vCalls() []struct {", idRecv, mockType, m.Name)
+	if g.conforms(intf) {
+		intfType := &model.NamedType{Package: g.pkg.PkgPath, Type: intf.Name}
+		g.p("// %v must implement %v, so the build breaks if it's stale.", mockType, intf.Name)
+		g.p("var _ %v = (*%v)(nil)", intfType.String(g.imports, g.c.Slf), mockType)
+		g.p("")
+	}
 
+	newMock := g.declared("New" + mockType)
+	g.p("// %v returns a %v that reports calls to methods without a", newMock, mockType)
+	g.p("// func through t, rather than panicking, and verifies it was used as")
+	g.p("// stubbed when the test's done.")
+	g.p("func %v(t %v.TB) *%v {", newMock, g.imports["testing"], mockType)
 	g.in()
-	for i, name := range argNames {
-		s := fmt.Sprintf("%v %v", strings.Title(name), argTypes[i])
-		s = strings.Replace(s, "...", "[]", -1)
-		g.p(s)
+	g.p("m := &%v{t: t}", mockType)
+	g.p("t.Cleanup(func() {")
+	g.in()
+	g.p("m.%v(t)", g.member("Verify"))
+	if g.c.Evt {
+		g.p("m.events.Close()")
 	}
 	g.out()
-	g.p("} {")
-
-	g.in()
-	g.p("%s.lock%s.Lock()", idRecv, m.Name)
-	g.p("defer %s.lock%s.Unlock()", idRecv, m.Name)
-	g.p("")
-	g.p("return %v.calls.%v", idRecv, m.Name)
+	g.p("})")
+	g.p("return m")
 	g.out()
 	g.p("}")
+	g.p("")
 
-	return nil
+	return g.GenerateMethods(mockType, intf)
 }
 
-func makeArgString(argNames, argTypes []string) string {
-	args := make([]string, len(argNames))
-	for i, name := range argNames {
-		// specify the type only once for consecutive args of the same type
-		if i+1 < len(argTypes) && argTypes[i] == argTypes[i+1] {
-			args[i] = name
-		} else {
-			args[i] = name + " " + argTypes[i]
-		}
+// allocateMembers allocates the names of the fields and methods the generator
+// adds to mockType, so they don't clash with the interface's methods, which
+// keep their names. The exported ones clashing get suffixed; clashing with
+// the unexported ones the mock keeps its state in is an error.
+func (g *Generator) allocateMembers(mockType string, methods []*model.Method) error {
+	names := make([]string, len(methods))
+	for i, m := range methods {
+		names[i] = m.Name
 	}
-	return strings.Join(args, ", ")
-}
-
-func (g *Generator) getArgNames(m *model.Method) []string {
-	argNames := make([]string, len(m.In))
-	for i, p := range m.In {
-		name := p.Name
-		if name == "" {
-			name = fmt.Sprintf("arg%d", i)
-		}
-		argNames[i] = name
+	internal := []string{"calls", "seqs", "conds", "onCalls", "queued", "unstubbed", "expectations", "events", "t"}
+	for _, m := range methods {
+		internal = append(internal, "lock"+m.Name)
 	}
-	if m.Variadic != nil {
-		name := m.Variadic.Name
-		if name == "" {
-			name = fmt.Sprintf("arg%d", len(m.In))
+	for _, name := range internal {
+		if contains(names, name) {
+			return fmt.Errorf("%v's method %v clashes with the mock's %v field", mockType, name, name)
 		}
-		argNames = append(argNames, name)
 	}
-	return argNames
-}
 
-func (g *Generator) getArgTypes(m *model.Method) []string {
-	argTypes := make([]string, len(m.In))
-	for i, p := range m.In {
-		argTypes[i] = p.Type.String(g.imports, g.c.Slf)
+	a := newIdentifierAllocator(nil, names...)
+	g.members = make(map[string]string)
+	allocate := func(want string) {
+		g.members[want] = a.allocateIdentifier(want)
 	}
-	if m.Variadic != nil {
-		argTypes = append(argTypes, "..."+m.Variadic.Type.String(g.imports, g.c.Slf))
+	allocateFor := func(format string, m *model.Method) {
+		g.members[methodMemberKey(format, m)] = a.allocateIdentifier(fmt.Sprintf(format, m.Name))
 	}
-	return argTypes
+	if g.c.Lax {
+		allocate("Strict")
+		allocate("NotStubbedErr")
+	}
+	if g.c.Dlg {
+		allocate("Delegate")
+	}
+	for _, m := range methods {
+		allocateFor("%vFunc", m)
+	}
+	allocate("Reset")
+	allocate("ResetStubs")
+	allocate("ResetAll")
+	allocate("Verify")
+	if g.c.Exp {
+		allocate("AssertExpectations")
+	}
+	if g.c.Evt {
+		allocate("Subscribe")
+	}
+	for _, m := range methods {
+		for _, format := range []string{
+			"%vCalled",
+			"%vCalls",
+			"%vCallCount",
+			"%vCallAt",
+			"%vLastCall",
+			"%vCallsWhere",
+			"Assert%vCalledWith",
+			"%vCall",
+			"Reset%v",
+			"On%vCall",
+			"%vReturnsSequence",
+			"With%v",
+			"WaitFor%v",
+		} {
+			allocateFor(format, m)
+		}
+		if g.c.Exp {
+			allocateFor("Expect%v", m)
+		}
+	}
+	return nil
 }
 
-// The name of the mock type to use for the given interface identifier.
-func (g *Generator) typeName(in string) string {
-	if out, ok := g.types[in]; ok {
-		return out
+// member returns the name the mock's field or method want got.
+func (g *Generator) member(want string) string {
+	if name, ok := g.members[want]; ok {
+		return name
 	}
-	return g.c.Pre + in + g.c.Suf
+	return want
 }
 
-func (g *Generator) p(format string, args ...interface{}) {
-	fmt.Fprintf(&g.buf, g.indent+format+"\n", args...)
+// methodMember returns the name the mock's field or method for m got, which
+// wanted format with m's name in, e.g. Reset%v. They're kept apart from the
+// mock's own, so ResetStubs for a Stubs method isn't mistaken for the mock's.
+func (g *Generator) methodMember(format string, m *model.Method) string {
+	if name, ok := g.members[methodMemberKey(format, m)]; ok {
+		return name
+	}
+	return fmt.Sprintf(format, m.Name)
 }
 
-func (g *Generator) in() {
-	g.indent += "\t"
+func methodMemberKey(format string, m *model.Method) string {
+	return format + " " + m.Name
 }
 
-func (g *Generator) out() {
-	if len(g.indent) > 0 {
-		g.indent = g.indent[0 : len(g.indent)-1]
+// conforms returns whether to assert the mock of intf implements it, which
+// needs intf to be accessible from the generated code. The source package
+// isn't imported just for the assertion, as the mock's package may be one it
+// imports, e.g. its tests' mocks, which would be a cycle; it's asserted when
+// the mocks refer to the source package anyway. From another package, a mock
+// can't implement an interface with unexported methods.
+func (g *Generator) conforms(intf *model.Interface) bool {
+	if g.c.Slf == g.pkg.PkgPath {
+		return true
 	}
-}
-
-type identifierAllocator map[string]struct{}
-
-func newIdentifierAllocator(taken []string) identifierAllocator {
-	a := make(identifierAllocator, len(taken))
-	for _, s := range taken {
-		a[s] = struct{}{}
+	if _, imported := g.imports[g.pkg.PkgPath]; !imported || !token.IsExported(intf.Name) {
+		return false
 	}
-	return a
-}
-
-func (o identifierAllocator) allocateIdentifier(want string) string {
-	id := want
-	for i := 2; ; i++ {
-		if _, ok := o[id]; !ok {
-			o[id] = struct{}{}
-			return id
+	for _, m := range intf.Methods {
+		if !token.IsExported(m.Name) {
+			return false
 		}
-		id = want + "_" + strconv.Itoa(i)
 	}
+	return true
 }
 
-// sanitize cleans up a string to make a suitable package name.
-func sanitize(s string) string {
-	t := ""
-	for _, r := range s {
+func (g *Generator) GenerateMethods(mockType string, intf *model.Interface) error {
+	methods := g.methods(intf)
+	for _, m := range methods {
+		g.p("")
+		if err := g.GenerateMethod(mockType, m); err != nil {
+			return err
+		}
+		g.p("")
+	}
+
+	g.p("// %v resets the calls made to the mocked methods.", g.member("Reset"))
+	g.p("func (m *%v) %v() {", mockType, g.member("Reset"))
+	g.in()
+	for _, m := range methods {
+		g.p("m.%v()", g.methodMember("Reset%v", m))
+	}
+	g.out()
+	g.p("}")
+	g.p("")
+
+	for _, ext := range g.c.Ext {
+		if ext.Reset == nil {
+			continue
+		}
+		if err := ext.Reset(g, mockType, intf); err != nil {
+			return err
+		}
+		g.p("")
+	}
+
+	g.p("// %v clears the funcs and per-call stubs of the mocked methods.", g.member("ResetStubs"))
+	g.p("func (m *%v) %v() {", mockType, g.member("ResetStubs"))
+	g.in()
+	for _, m := range methods {
+		g.p("m.lock%v.Lock()", m.Name)
+		g.p("m.%v = nil", g.methodMember("%vFunc", m))
+		g.p("m.onCalls.%v = nil", m.Name)
+		g.p("m.queued.%v = nil", m.Name)
+		g.p("m.lock%v.Unlock()", m.Name)
+	}
+	g.out()
+	g.p("}")
+	g.p("")
+
+	g.p("// %v resets the calls made to the mocked methods and clears their", g.member("ResetAll"))
+	g.p("// funcs and per-call stubs.")
+	g.p("func (m *%v) %v() {", mockType, g.member("ResetAll"))
+	g.in()
+	g.p("m.%v()", g.member("Reset"))
+	g.p("m.%v()", g.member("ResetStubs"))
+	g.out()
+	g.p("}")
+
+	g.p("")
+	g.p("// %v reports through t any calls made to methods without a func, funcs", g.member("Verify"))
+	g.p("// and per-call stubs that weren't called, and unmet expectations. It returns")
+	g.p("// whether there were none.")
+	g.p("func (m *%v) %v(t %v.TB) bool {", mockType, g.member("Verify"), g.imports["testing"])
+	g.in()
+	g.p("t.Helper()")
+	g.p("ok := true")
+	for _, m := range methods {
+		g.p("m.lock%v.Lock()", m.Name)
+		g.p("if m.unstubbed.%v > 0 {", m.Name)
+		g.in()
+		g.p("t.Errorf(\"mocker: %v.%v was called %%v times without a func\", m.unstubbed.%v)", mockType, m.Name, m.Name)
+		g.p("ok = false")
+		g.out()
+		g.p("}")
+		g.p("if m.%v != nil && len(m.calls.%v) == 0 {", g.methodMember("%vFunc", m), m.Name)
+		g.in()
+		g.p("t.Errorf(\"mocker: %v.%v is set but %v wasn't called\")", mockType, g.methodMember("%vFunc", m), m.Name)
+		g.p("ok = false")
+		g.out()
+		g.p("}")
+		g.p("for n := range m.onCalls.%v {", m.Name)
+		g.in()
+		g.p("t.Errorf(\"mocker: %v.%v's stub for call %%v wasn't called\", n)", mockType, m.Name)
+		g.p("ok = false")
+		g.out()
+		g.p("}")
+		g.p("if len(m.queued.%v) > 0 {", m.Name)
+		g.in()
+		g.p("t.Errorf(\"mocker: %v.%v's last %%v stubs in sequence weren't called\", len(m.queued.%v))", mockType, m.Name, m.Name)
+		g.p("ok = false")
+		g.out()
+		g.p("}")
+		g.p("m.lock%v.Unlock()", m.Name)
+	}
+	if g.c.Exp {
+		g.p("if !m.expectations.Assert(t) {")
+		g.in()
+		g.p("ok = false")
+		g.out()
+		g.p("}")
+	}
+	g.p("return ok")
+	g.out()
+	g.p("}")
+
+	if g.c.Evt {
+		g.p("")
+		g.p("// %v returns a channel receiving the calls made to the mock from now", g.member("Subscribe"))
+		g.p("// on, once they've returned or panicked, and a func unsubscribing it.")
+		g.p("// Unsubscribing closes the channel, and subscribers are unsubscribed")
+		g.p("// when the test's done.")
+		g.p("func (m *%v) %v() (<-chan %v.CallEvent, func()) {", mockType, g.member("Subscribe"), g.imports[runtimePath])
+		g.in()
+		g.p("return m.events.Subscribe()")
+		g.out()
+		g.p("}")
+	}
+
+	if g.c.Exp {
+		g.p("")
+		g.p("// %v reports through t any expected calls that weren't made", g.member("AssertExpectations"))
+		g.p("// as many times as expected, and any unexpected calls. It returns whether")
+		g.p("// there were none.")
+		g.p("func (m *%v) %v(t %v.TB) bool {", mockType, g.member("AssertExpectations"), g.imports["testing"])
+		g.in()
+		g.p("t.Helper()")
+		g.p("return m.expectations.Assert(t)")
+		g.out()
+		g.p("}")
+	}
+
+	return nil
+}
+
+func (g *Generator) GenerateMethod(mockType string, m *model.Method) error {
+	// Parameters are allocated first so the mocked signature keeps the
+	// interface's names wherever they don't shadow an import or the mock's
+	// own identifiers, then the receiver and locals get whatever's left.
+	scope := newIdentifierAllocator(g.scope)
+	argNames := g.getArgNames(m, scope)
+	fieldNames, retFields := g.getFieldNames(m)
+	fieldTypes := g.getFieldTypes(m)
+	argTypes := g.getArgTypes(m)
+	argString := makeArgString(argNames, argTypes)
+	funcType := g.declared(funcTypeName(mockType, m))
+	callType := g.declared(callTypeName(mockType, m))
+	fnField := g.methodMember("%vFunc", m)
+
+	rets := make([]string, len(m.Out))
+	for i, p := range m.Out {
+		rets[i] = p.Type.String(g.imports, g.c.Slf)
+	}
+	retString := strings.Join(rets, ", ")
+	if len(rets) > 1 {
+		retString = "(" + retString + ")"
+	}
+	if retString != "" {
+		retString = " " + retString
+	}
+
+	idRecv := scope.allocateIdentifier("m")
+	idCall := scope.allocateIdentifier("call")
+	idCalls := scope.allocateIdentifier("calls")
+	idFunc := scope.allocateIdentifier("fn")
+	idOnCall := scope.allocateIdentifier("onCall")
+	idOk := scope.allocateIdentifier("ok")
+	idExp := scope.allocateIdentifier("exp")
+	idT := scope.allocateIdentifier("t")
+	idSeqs := scope.allocateIdentifier("seqs")
+	idI := scope.allocateIdentifier("i")
+	idErr := scope.allocateIdentifier("notStubbedErr")
+
+	g.p("// %v is the func %v.%v calls.", funcType, mockType, m.Name)
+	g.p("type %v func(%v)%v", funcType, argString, retString)
+	g.p("")
+
+	g.p("// %v is a call made to %v.%v.", callType, mockType, m.Name)
+	g.p("type %v struct {", callType)
+	g.in()
+	for i, name := range fieldNames {
+		g.p("%v %v", name, fieldTypes[i])
+	}
+	if len(fieldNames) > 0 {
+		g.p("")
+	}
+	for i, name := range retFields {
+		g.p("%v %v", name, rets[i])
+	}
+	if len(retFields) > 0 {
+		g.p("")
+	}
+	g.p("// Panic is the value the call panicked with, if it did.")
+	g.p("Panic interface{}")
+	if g.c.Trc {
+		g.p("")
+		g.p("// Caller is the file:line the call was made from.")
+		g.p("Caller string")
+		g.p("// Time is when the call was made.")
+		g.p("Time %v.Time", g.imports["time"])
+		g.p("// Goroutine is the ID of the goroutine the call was made on.")
+		g.p("Goroutine uint64")
+	}
+	g.out()
+	g.p("}")
+	g.p("")
+
+	g.p("// %v mocks base method by wrapping the associated func.", m.Name)
+	g.p("func (%v *%v) %v(%v)%v {", idRecv, mockType, m.Name, argString, retString)
+	g.in()
+	// The lock guards recording the call and reading the func but isn't held
+	// while the func runs, so funcs can call back into the mock and
+	// concurrent calls aren't serialized.
+	g.p("%s.lock%s.Lock()", idRecv, m.Name)
+	if g.c.Exp {
+		g.p("%v := %v.expectations.Call(%q%v)", idExp, idRecv, m.Name, prependComma(argNames))
+	}
+	g.p("%v := %v.%v", idFunc, idRecv, fnField)
+	g.p("if %v, %v := %v.onCalls.%v[len(%v.calls.%v)]; %v {", idOnCall, idOk, idRecv, m.Name, idRecv, m.Name, idOk)
+	g.in()
+	g.p("%v = %v", idFunc, idOnCall)
+	g.p("delete(%v.onCalls.%v, len(%v.calls.%v))", idRecv, m.Name, idRecv, m.Name)
+	g.out()
+	g.p("} else if len(%v.queued.%v) > 0 {", idRecv, m.Name)
+	g.in()
+	g.p("%v = %v.queued.%v[0]", idFunc, idRecv, m.Name)
+	g.p("%v.queued.%v = %v.queued.%v[1:]", idRecv, m.Name, idRecv, m.Name)
+	g.out()
+	g.p("}")
+	if g.c.Dlg {
+		g.p("if %v == nil && %v.%v != nil {", idFunc, idRecv, g.member("Delegate"))
+		g.in()
+		g.p("%v = %v.%v.%v", idFunc, idRecv, g.member("Delegate"), m.Name)
+		g.out()
+		g.p("}")
+	}
+	unstubbed := []string{idFunc + " == nil"}
+	if g.c.Exp {
+		unstubbed = append(unstubbed, idExp+" == nil")
+	}
+	if g.c.Lax {
+		unstubbed = append(unstubbed, idRecv+"."+g.member("Strict"))
+	}
+	g.p("if %v {", strings.Join(unstubbed, " && "))
+	g.in()
+	g.p("if %v.t == nil {", idRecv)
+	g.in()
+	g.p("%s.lock%s.Unlock()", idRecv, m.Name)
+	g.p("panic(\"mocker: %v.%v is nil but %v.%v was called.\")", mockType, fnField, mockType, m.Name)
+	g.out()
+	g.p("}")
+	g.p("%v.unstubbed.%v++", idRecv, m.Name)
+	g.out()
+	g.p("}")
+	returnsErr := g.c.Lax && returnsError(m)
+	if returnsErr {
+		g.p("%v := %v.%v", idErr, idRecv, g.member("NotStubbedErr"))
+	}
+	g.p("%v := &%v{", idCall, callType)
+	g.in()
+	for i, name := range argNames {
+		g.p("%v: %v,", fieldNames[i], name)
+	}
+	if g.c.Trc {
+		g.p("Caller: %v.Caller(),", g.imports[runtimePath])
+		g.p("Time: %v.Now(),", g.imports["time"])
+		g.p("Goroutine: %v.Goroutine(),", g.imports[runtimePath])
+	}
+	g.out()
+	g.p("}")
+	g.p("%v.calls.%v = append(%v.calls.%v, %v)", idRecv, m.Name, idRecv, m.Name, idCall)
+	g.p("%v.seqs.%v = append(%v.seqs.%v, %v.Sequence())", idRecv, m.Name, idRecv, m.Name, g.imports[runtimePath])
+	g.p("if %v.conds.%v != nil {", idRecv, m.Name)
+	g.in()
+	g.p("%v.conds.%v.Broadcast()", idRecv, m.Name)
+	g.out()
+	g.p("}")
+	g.p("%s.lock%s.Unlock()", idRecv, m.Name)
+	g.p("")
+
+	retNames := make([]string, len(m.Out))
+	for i := range m.Out {
+		retNames[i] = scope.allocateIdentifier(fmt.Sprintf("ret%d", i))
+		g.p("var %v %v", retNames[i], rets[i])
+	}
+
+	// The results and panic are recorded once the func's done, and the panic
+	// is carried on with so the mock doesn't change what the code under test
+	// sees.
+	idPanic := scope.allocateIdentifier("r")
+	g.p("defer func() {")
+	g.in()
+	g.p("%v := recover()", idPanic)
+	g.p("%s.lock%s.Lock()", idRecv, m.Name)
+	for i, name := range retFields {
+		g.p("%v.%v = %v", idCall, name, retNames[i])
+	}
+	g.p("%v.Panic = %v", idCall, idPanic)
+	g.p("%s.lock%s.Unlock()", idRecv, m.Name)
+	if g.c.Evt {
+		g.p("%v.events.Publish(%v.CallEvent{", idRecv, g.imports[runtimePath])
+		g.in()
+		g.p("Mock: %q,", mockType)
+		g.p("Method: %q,", m.Name)
+		g.p("Args: []interface{}{%v},", strings.Join(argNames, ", "))
+		g.p("Results: []interface{}{%v},", strings.Join(retNames, ", "))
+		g.p("Panic: %v,", idPanic)
+		g.out()
+		g.p("})")
+	}
+	g.p("if %v != nil {", idPanic)
+	g.in()
+	g.p("panic(%v)", idPanic)
+	g.out()
+	g.p("}")
+	g.out()
+	g.p("}()")
+	g.p("")
+
+	var callArgs string
+	if len(argNames) > 0 {
+		callArgs = strings.Join(argNames, ", ")
+	}
+
+	if m.Variadic != nil {
+		callArgs += "..."
+	}
+
+	if g.c.Exp {
+		g.p("if %v != nil {", idExp)
+		g.in()
+		for i := range m.Out {
+			g.p("%v, _ = %v.Result(%d).(%v)", retNames[i], idExp, i, rets[i])
+		}
+		if len(m.Out) == 0 {
+			g.p("return")
+		} else {
+			g.p("return %v", strings.Join(retNames, ", "))
+		}
+		g.out()
+		g.p("}")
+		g.p("")
+	}
+
+	g.p("if %v == nil {", idFunc)
+	g.in()
+	if returnsErr {
+		g.p("%v = %v", retNames[len(retNames)-1], idErr)
+	}
+	if len(m.Out) == 0 {
+		g.p("return")
+	} else {
+		g.p("return %v", strings.Join(retNames, ", "))
+	}
+	g.out()
+	g.p("}")
+	g.p("")
+
+	if len(m.Out) == 0 {
+		g.p(`%v(%v)`, idFunc, callArgs)
+	} else {
+		g.p(`%v = %v(%v)`, strings.Join(retNames, ", "), idFunc, callArgs)
+		g.p(`return %v`, strings.Join(retNames, ", "))
+	}
+
+	g.out()
+	g.p("}")
+	g.p("")
+
+	g.p("// %v returns true if %v was called at least once.", g.methodMember("%vCalled", m), m.Name)
+	g.p("func (%v *%v) %v() bool {", idRecv, mockType, g.methodMember("%vCalled", m))
+	g.in()
+	g.p("%s.lock%s.Lock()", idRecv, m.Name)
+	g.p("defer %s.lock%s.Unlock()", idRecv, m.Name)
+	g.p("")
+	g.p("return len(%v.calls.%v) > 0", idRecv, m.Name)
+	g.out()
+	g.p("}")
+
+	g.p("// %v returns the calls made to %v.", g.methodMember("%vCalls", m), m.Name)
+	g.p("func (%v *%v) %v() []%v {", idRecv, mockType, g.methodMember("%vCalls", m), callType)
+	g.in()
+	g.p("%s.lock%s.Lock()", idRecv, m.Name)
+	g.p("defer %s.lock%s.Unlock()", idRecv, m.Name)
+	g.p("")
+	g.p("var %v []%v", idCalls, callType)
+	g.p("for _, %v := range %v.calls.%v {", idCall, idRecv, m.Name)
+	g.in()
+	g.p("%v = append(%v, *%v)", idCalls, idCalls, idCall)
+	g.out()
+	g.p("}")
+	g.p("return %v", idCalls)
+	g.out()
+	g.p("}")
+	g.p("")
+
+	g.p("// %v returns the number of calls made to %v.", g.methodMember("%vCallCount", m), m.Name)
+	g.p("func (%v *%v) %v() int {", idRecv, mockType, g.methodMember("%vCallCount", m))
+	g.in()
+	g.p("%s.lock%s.Lock()", idRecv, m.Name)
+	g.p("defer %s.lock%s.Unlock()", idRecv, m.Name)
+	g.p("")
+	g.p("return len(%v.calls.%v)", idRecv, m.Name)
+	g.out()
+	g.p("}")
+	g.p("")
+
+	g.p("// %v returns the i'th call made to %v, counting from 0, failing the", g.methodMember("%vCallAt", m), m.Name)
+	g.p("// test through t if there weren't that many calls.")
+	g.p("func (%v *%v) %v(%v %v.TB, %v int) %v {", idRecv, mockType, g.methodMember("%vCallAt", m), idT, g.imports["testing"], idI, callType)
+	g.in()
+	g.p("%v.Helper()", idT)
+	g.p("%s.lock%s.Lock()", idRecv, m.Name)
+	g.p("defer %s.lock%s.Unlock()", idRecv, m.Name)
+	g.p("")
+	g.p("if %v < 0 || %v >= len(%v.calls.%v) {", idI, idI, idRecv, m.Name)
+	g.in()
+	g.p("%v.Fatalf(\"mocker: %v.%v was called %%v times, wanted call %%v\", len(%v.calls.%v), %v)", idT, mockType, m.Name, idRecv, m.Name, idI)
+	g.out()
+	g.p("}")
+	g.p("return *%v.calls.%v[%v]", idRecv, m.Name, idI)
+	g.out()
+	g.p("}")
+	g.p("")
+
+	g.p("// %v returns the last call made to %v, failing the test through t", g.methodMember("%vLastCall", m), m.Name)
+	g.p("// if there weren't any.")
+	g.p("func (%v *%v) %v(%v %v.TB) %v {", idRecv, mockType, g.methodMember("%vLastCall", m), idT, g.imports["testing"], callType)
+	g.in()
+	g.p("%v.Helper()", idT)
+	g.p("%s.lock%s.Lock()", idRecv, m.Name)
+	g.p("defer %s.lock%s.Unlock()", idRecv, m.Name)
+	g.p("")
+	g.p("if len(%v.calls.%v) == 0 {", idRecv, m.Name)
+	g.in()
+	g.p("%v.Fatalf(\"mocker: %v.%v wasn't called\")", idT, mockType, m.Name)
+	g.out()
+	g.p("}")
+	g.p("return *%v.calls.%v[len(%v.calls.%v)-1]", idRecv, m.Name, idRecv, m.Name)
+	g.out()
+	g.p("}")
+	g.p("")
+
+	g.p("// %v returns the calls made to %v that fn returns true for.", g.methodMember("%vCallsWhere", m), m.Name)
+	g.p("func (%v *%v) %v(%v func(%v) bool) []%v {", idRecv, mockType, g.methodMember("%vCallsWhere", m), idFunc, callType, callType)
+	g.in()
+	g.p("var %v []%v", idCalls, callType)
+	g.p("for _, %v := range %v.%v() {", idCall, idRecv, g.methodMember("%vCalls", m))
+	g.in()
+	g.p("if %v(%v) {", idFunc, idCall)
+	g.in()
+	g.p("%v = append(%v, %v)", idCalls, idCalls, idCall)
+	g.out()
+	g.p("}")
+	g.out()
+	g.p("}")
+	g.p("return %v", idCalls)
+	g.out()
+	g.p("}")
+	g.p("")
+
+	matchers := make([]string, len(argNames))
+	for i, name := range argNames {
+		matchers[i] = fmt.Sprintf("%v.Match(%v.%v)", name, idCall, fieldNames[i])
+	}
+	params := ""
+	if len(argNames) > 0 {
+		params = ", " + strings.Join(argNames, ", ") + " " + g.imports[matchPath] + ".Matcher"
+	}
+	g.p("// %v reports through t unless %v was called with args", g.methodMember("Assert%vCalledWith", m), m.Name)
+	g.p("// matching the matchers, and returns whether it was.")
+	g.p("func (%v *%v) %v(%v %v.TB%v) bool {", idRecv, mockType, g.methodMember("Assert%vCalledWith", m), idT, g.imports["testing"], params)
+	g.in()
+	g.p("%v.Helper()", idT)
+	g.p("%s.lock%s.Lock()", idRecv, m.Name)
+	g.p("defer %s.lock%s.Unlock()", idRecv, m.Name)
+	g.p("")
+	if len(argNames) == 0 {
+		// any call matches, and there's no call to match against.
+		g.p("if len(%v.calls.%v) > 0 {", idRecv, m.Name)
+		g.in()
+		g.p("return true")
+		g.out()
+		g.p("}")
+	} else {
+		g.p("for _, %v := range %v.calls.%v {", idCall, idRecv, m.Name)
+		g.in()
+		g.p("if %v {", strings.Join(matchers, " && "))
+		g.in()
+		g.p("return true")
+		g.out()
+		g.p("}")
+		g.out()
+		g.p("}")
+	}
+	if len(argNames) == 0 {
+		g.p("%v.Errorf(\"mocker: %v.%v wasn't called\")", idT, mockType, m.Name)
+	} else {
+		verbs := strings.TrimSuffix(strings.Repeat("%v, ", len(argNames)), ", ")
+		g.p("%v.Errorf(\"mocker: %v.%v wasn't called with (%v)\", %v)", idT, mockType, m.Name, verbs, strings.Join(argNames, ", "))
+	}
+	g.p("return false")
+	g.out()
+	g.p("}")
+	g.p("")
+
+	args := ""
+	if len(argNames) > 0 {
+		args = strings.Join(argNames, ", ") + " interface{}"
+	}
+	argMatchers := make([]string, len(argNames))
+	for i, name := range argNames {
+		argMatchers[i] = fmt.Sprintf("%v.Of(%v).Match(%v.%v)", g.imports[matchPath], name, idCall, fieldNames[i])
+	}
+	g.p("// %v describes the calls made to %v with args matching args, which", g.methodMember("%vCall", m), m.Name)
+	g.p("// may be match.Matchers, for asserting on their order with mocker.InOrder.")
+	g.p("func (%v *%v) %v(%v) %v.Call {", idRecv, mockType, g.methodMember("%vCall", m), args, g.imports[runtimePath])
+	g.in()
+	g.p("%s.lock%s.Lock()", idRecv, m.Name)
+	g.p("defer %s.lock%s.Unlock()", idRecv, m.Name)
+	g.p("")
+	g.p("var %v []uint64", idSeqs)
+	if len(argMatchers) == 0 {
+		// every call matches, and there's no call to match against.
+		g.p("%v = append(%v, %v.seqs.%v...)", idSeqs, idSeqs, idRecv, m.Name)
+	} else {
+		g.p("for %v, %v := range %v.calls.%v {", idI, idCall, idRecv, m.Name)
+		g.in()
+		g.p("if %v {", strings.Join(argMatchers, " && "))
+		g.in()
+		g.p("%v = append(%v, %v.seqs.%v[%v])", idSeqs, idSeqs, idRecv, m.Name, idI)
+		g.out()
+		g.p("}")
+		g.out()
+		g.p("}")
+	}
+	g.p("return %v.Call{", g.imports[runtimePath])
+	g.in()
+	g.p("Desc: %v.Describe(%q%v),", g.imports[runtimePath], mockType+"."+m.Name, prependComma(argNames))
+	g.p("Seqs: %v,", idSeqs)
+	g.out()
+	g.p("}")
+	g.out()
+	g.p("}")
+	g.p("")
+
+	// Waiters wait on a cond signaled by each call. The cond can't wait on
+	// the context too, so a goroutine signals it when the context's done.
+	idCtx := scope.allocateIdentifier("ctx")
+	idN := scope.allocateIdentifier("n")
+	idStop := scope.allocateIdentifier("stop")
+	idCtxErr := scope.allocateIdentifier("err")
+	g.p("// %v blocks until %v has been called at least %v times, returning", g.methodMember("WaitFor%v", m), m.Name, idN)
+	g.p("// nil, or until %v is done, returning its error.", idCtx)
+	g.p("func (%v *%v) %v(%v %v.Context, %v int) error {", idRecv, mockType, g.methodMember("WaitFor%v", m), idCtx, g.imports["context"], idN)
+	g.in()
+	g.p("%s.lock%s.Lock()", idRecv, m.Name)
+	g.p("defer %s.lock%s.Unlock()", idRecv, m.Name)
+	g.p("")
+	g.p("if %v.conds.%v == nil {", idRecv, m.Name)
+	g.in()
+	g.p("%v.conds.%v = %v.NewCond(&%v.lock%v)", idRecv, m.Name, g.imports["sync"], idRecv, m.Name)
+	g.out()
+	g.p("}")
+	g.p("%v := make(chan struct{})", idStop)
+	g.p("defer close(%v)", idStop)
+	g.p("go func() {")
+	g.in()
+	g.p("select {")
+	g.p("case <-%v.Done():", idCtx)
+	g.in()
+	g.p("%s.lock%s.Lock()", idRecv, m.Name)
+	g.p("%v.conds.%v.Broadcast()", idRecv, m.Name)
+	g.p("%s.lock%s.Unlock()", idRecv, m.Name)
+	g.out()
+	g.p("case <-%v:", idStop)
+	g.p("}")
+	g.out()
+	g.p("}()")
+	g.p("for len(%v.calls.%v) < %v {", idRecv, m.Name, idN)
+	g.in()
+	g.p("if %v := %v.Err(); %v != nil {", idCtxErr, idCtx, idCtxErr)
+	g.in()
+	g.p("return %v", idCtxErr)
+	g.out()
+	g.p("}")
+	g.p("%v.conds.%v.Wait()", idRecv, m.Name)
+	g.out()
+	g.p("}")
+	g.p("return nil")
+	g.out()
+	g.p("}")
+	g.p("")
+
+	idOnCalls := scope.allocateIdentifier("onCalls")
+	g.p("// %v resets the calls made to %v. Stubs set with %v for calls", g.methodMember("Reset%v", m), m.Name, g.methodMember("On%vCall", m))
+	g.p("// yet to be made keep their calls, now counted from the reset.")
+	g.p("func (%v *%v) %v() {", idRecv, mockType, g.methodMember("Reset%v", m))
+	g.in()
+	g.p("%s.lock%s.Lock()", idRecv, m.Name)
+	g.p("defer %s.lock%s.Unlock()", idRecv, m.Name)
+	g.p("")
+	g.p("if %v := len(%v.calls.%v); %v > 0 && %v.onCalls.%v != nil {", idN, idRecv, m.Name, idN, idRecv, m.Name)
+	g.in()
+	g.p("%v := make(map[int]%v, len(%v.onCalls.%v))", idOnCalls, funcType, idRecv, m.Name)
+	g.p("for %v, %v := range %v.onCalls.%v {", idI, idFunc, idRecv, m.Name)
+	g.in()
+	g.p("if %v >= %v {", idI, idN)
+	g.in()
+	g.p("%v[%v-%v] = %v", idOnCalls, idI, idN, idFunc)
+	g.out()
+	g.p("}")
+	g.out()
+	g.p("}")
+	g.p("%v.onCalls.%v = %v", idRecv, m.Name, idOnCalls)
+	g.out()
+	g.p("}")
+	g.p("%v.calls.%v = nil", idRecv, m.Name)
+	g.p("%v.seqs.%v = nil", idRecv, m.Name)
+	g.p("%v.unstubbed.%v = 0", idRecv, m.Name)
+	g.out()
+	g.p("}")
+	g.p("")
+
+	g.p("// %v makes the n'th call to %v, counting from 0 like %v, call fn", g.methodMember("On%vCall", m), m.Name, g.methodMember("%vCalls", m))
+	g.p("// rather than %v.", fnField)
+	g.p("func (%v *%v) %v(n int, fn %v) {", idRecv, mockType, g.methodMember("On%vCall", m), funcType)
+	g.in()
+	g.p("%s.lock%s.Lock()", idRecv, m.Name)
+	g.p("defer %s.lock%s.Unlock()", idRecv, m.Name)
+	g.p("")
+	g.p("if %v.onCalls.%v == nil {", idRecv, m.Name)
+	g.in()
+	g.p("%v.onCalls.%v = make(map[int]%v)", idRecv, m.Name, funcType)
+	g.out()
+	g.p("}")
+	g.p("%v.onCalls.%v[n] = fn", idRecv, m.Name)
+	g.out()
+	g.p("}")
+	g.p("")
+
+	g.p("// %v queues fns for the next calls to %v to call in turn, one", g.methodMember("%vReturnsSequence", m), m.Name)
+	g.p("// per call, after any queued before, then falling back to %v. Calls", fnField)
+	g.p("// stubbed with %v don't use up the queue.", g.methodMember("On%vCall", m))
+	g.p("func (%v *%v) %v(fns ...%v) {", idRecv, mockType, g.methodMember("%vReturnsSequence", m), funcType)
+	g.in()
+	g.p("%s.lock%s.Lock()", idRecv, m.Name)
+	g.p("defer %s.lock%s.Unlock()", idRecv, m.Name)
+	g.p("")
+	g.p("%v.queued.%v = append(%v.queued.%v, fns...)", idRecv, m.Name, idRecv, m.Name)
+	g.out()
+	g.p("}")
+	g.p("")
+
+	g.p("// %v sets %v to fn, taking the lock calls to %v take so it's safe", g.methodMember("With%v", m), fnField, m.Name)
+	g.p("// once the mock's shared, and returns the mock.")
+	g.p("func (%v *%v) %v(fn %v) *%v {", idRecv, mockType, g.methodMember("With%v", m), funcType, mockType)
+	g.in()
+	g.p("%s.lock%s.Lock()", idRecv, m.Name)
+	g.p("%v.%v = fn", idRecv, fnField)
+	g.p("%s.lock%s.Unlock()", idRecv, m.Name)
+	g.p("return %v", idRecv)
+	g.out()
+	g.p("}")
+
+	if g.c.Exp {
+		g.p("")
+		g.generateExpectation(mockType, m, idRecv, argNames, rets)
+	}
+
+	for _, ext := range g.c.Ext {
+		if ext.Method == nil {
+			continue
+		}
+		g.p("")
+		if err := ext.Method(g, mockType, m); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// generateExpectation generates the method adding an expectation of a call to
+// m and the type it returns to set the expectation's results and count.
+func (g *Generator) generateExpectation(mockType string, m *model.Method, idRecv string, argNames, rets []string) {
+	expType := g.declared(expectationTypeName(mockType, m))
+
+	g.p("// %v is an expected call to %v.%v.", expType, mockType, m.Name)
+	g.p("type %v struct {", expType)
+	g.in()
+	g.p("exp *%v.Expectation", g.imports[runtimePath])
+	g.out()
+	g.p("}")
+	g.p("")
+
+	args := ""
+	if len(argNames) > 0 {
+		args = strings.Join(argNames, ", ") + " interface{}"
+	}
+	g.p("// %v expects a call to %v with args, once unless set otherwise.", g.methodMember("Expect%v", m), m.Name)
+	g.p("// Calls to %v are then unexpected unless they meet one of its expectations.", m.Name)
+	g.p("func (%v *%v) %v(%v) *%v {", idRecv, mockType, g.methodMember("Expect%v", m), args, expType)
+	g.in()
+	g.p("return &%v{exp: %v.expectations.Expect(%q%v)}", expType, idRecv, m.Name, prependComma(argNames))
+	g.out()
+	g.p("}")
+	g.p("")
+
+	if len(rets) > 0 {
+		retNames := make([]string, len(rets))
+		params := make([]string, len(rets))
+		for i := range rets {
+			retNames[i] = fmt.Sprintf("ret%d", i)
+			params[i] = retNames[i] + " " + rets[i]
+		}
+		g.p("// Return sets what the expected call returns.")
+		g.p("func (e *%v) Return(%v) *%v {", expType, strings.Join(params, ", "), expType)
+		g.in()
+		g.p("e.exp.Returns(%v)", strings.Join(retNames, ", "))
+		g.p("return e")
+		g.out()
+		g.p("}")
+		g.p("")
+	}
+
+	g.p("// Times sets how many times the call's expected.")
+	g.p("func (e *%v) Times(n int) *%v {", expType, expType)
+	g.in()
+	g.p("e.exp.Times(n)")
+	g.p("return e")
+	g.out()
+	g.p("}")
+}
+
+func makeArgString(argNames, argTypes []string) string {
+	args := make([]string, len(argNames))
+	for i, name := range argNames {
+		// specify the type only once for consecutive args of the same type
+		if i+1 < len(argTypes) && argTypes[i] == argTypes[i+1] {
+			args[i] = name
+		} else {
+			args[i] = name + " " + argTypes[i]
+		}
+	}
+	return strings.Join(args, ", ")
+}
+
+// getArgNames returns the names of m's parameters, allocated in scope so they
+// don't shadow the imports or the identifiers generated in the method.
+func (g *Generator) getArgNames(m *model.Method, scope *identifierAllocator) []string {
+	argNames := make([]string, len(m.In))
+	for i, p := range m.In {
+		argNames[i] = scope.allocateIdentifier(argName(p.Name, i))
+	}
+	if m.Variadic != nil {
+		argNames = append(argNames, scope.allocateIdentifier(argName(m.Variadic.Name, len(m.In))))
+	}
+	return argNames
+}
+
+// getFieldNames returns the names of the fields recording m's parameters and
+// results in its calls. They're derived from the interface's names rather than
+// the allocated ones so they don't depend on what the mock happens to import.
+func (g *Generator) getFieldNames(m *model.Method) (args, rets []string) {
+	fa := newIdentifierAllocator(nil, "Panic")
+	if g.c.Trc {
+		fa = newIdentifierAllocator(nil, "Panic", "Caller", "Time", "Goroutine")
+	}
+	args = make([]string, len(m.In))
+	for i, p := range m.In {
+		args[i] = fa.allocateIdentifier(strings.Title(argName(p.Name, i)))
+	}
+	if m.Variadic != nil {
+		args = append(args, fa.allocateIdentifier(strings.Title(argName(m.Variadic.Name, len(m.In)))))
+	}
+	rets = make([]string, len(m.Out))
+	for i, p := range m.Out {
+		name := p.Name
+		if name == "" || name == "_" {
+			name = fmt.Sprintf("ret%d", i)
+		}
+		rets[i] = fa.allocateIdentifier(strings.Title(name))
+	}
+	return args, rets
+}
+
+// argName returns the name for the i'th parameter, naming it after its
+// position if it's unnamed or blank.
+func argName(name string, i int) string {
+	if name == "" || name == "_" {
+		return fmt.Sprintf("arg%d", i)
+	}
+	return name
+}
+
+func (g *Generator) getArgTypes(m *model.Method) []string {
+	argTypes := make([]string, len(m.In))
+	for i, p := range m.In {
+		argTypes[i] = p.Type.String(g.imports, g.c.Slf)
+	}
+	if m.Variadic != nil {
+		argTypes = append(argTypes, "..."+m.Variadic.Type.String(g.imports, g.c.Slf))
+	}
+	return argTypes
+}
+
+// methods returns the interface's methods in the configured output order.
+func (g *Generator) methods(intf *model.Interface) []*model.Method {
+	methods := make([]*model.Method, len(intf.Methods))
+	copy(methods, intf.Methods)
+	if g.c.Ord == OrderAlpha {
+		sort.SliceStable(methods, func(i, j int) bool {
+			return methods[i].Name < methods[j].Name
+		})
+	}
+	return methods
+}
+
+// getFieldTypes returns the types of the fields recording m's parameters in
+// its calls, where a variadic parameter is recorded as a slice.
+func (g *Generator) getFieldTypes(m *model.Method) []string {
+	fieldTypes := make([]string, len(m.In))
+	for i, p := range m.In {
+		fieldTypes[i] = p.Type.String(g.imports, g.c.Slf)
+	}
+	if m.Variadic != nil {
+		slice := &model.ArrayType{Len: -1, Type: m.Variadic.Type}
+		fieldTypes = append(fieldTypes, slice.String(g.imports, g.c.Slf))
+	}
+	return fieldTypes
+}
+
+// returnsError returns whether m's last result is an error.
+func returnsError(m *model.Method) bool {
+	if len(m.Out) == 0 {
+		return false
+	}
+	t, ok := m.Out[len(m.Out)-1].Type.(model.PredeclaredType)
+	return ok && t == "error"
+}
+
+// prependComma returns ss joined by commas, after a comma of its own if it's
+// not empty, to follow other arguments.
+func prependComma(ss []string) string {
+	if len(ss) == 0 {
+		return ""
+	}
+	return ", " + strings.Join(ss, ", ")
+}
+
+// funcTypeName returns the name of the func type stubbing m on mockType.
+func funcTypeName(mockType string, m *model.Method) string {
+	return mockType + m.Name + "Func"
+}
+
+// callTypeName returns the name of the type recording calls to m on mockType.
+func callTypeName(mockType string, m *model.Method) string {
+	return mockType + m.Name + "Call"
+}
+
+// expectationTypeName returns the name of the type of expected calls to m on
+// mockType.
+func expectationTypeName(mockType string, m *model.Method) string {
+	return mockType + m.Name + "Expectation"
+}
+
+// The name of the mock type to use for the given interface identifier.
+func (g *Generator) typeName(in string) string {
+	return g.declared(g.c.Pre + in + g.c.Suf)
+}
+
+func (g *Generator) p(format string, args ...interface{}) {
+	fmt.Fprintf(&g.buf, g.indent+format+"\n", args...)
+}
+
+func (g *Generator) in() {
+	g.indent += "\t"
+}
+
+func (g *Generator) out() {
+	if len(g.indent) > 0 {
+		g.indent = g.indent[0 : len(g.indent)-1]
+	}
+}
+
+// identifierAllocator hands out identifiers that are unique within its scope
+// and don't shadow any identifier taken in its enclosing scopes.
+type identifierAllocator struct {
+	parent *identifierAllocator
+	taken  map[string]struct{}
+}
+
+func newIdentifierAllocator(parent *identifierAllocator, taken ...string) *identifierAllocator {
+	a := &identifierAllocator{
+		parent: parent,
+		taken:  make(map[string]struct{}, len(taken)),
+	}
+	for _, s := range taken {
+		a.taken[s] = struct{}{}
+	}
+	return a
+}
+
+func (o *identifierAllocator) isTaken(id string) bool {
+	if token.Lookup(id).IsKeyword() {
+		return true
+	}
+	for a := o; a != nil; a = a.parent {
+		if _, ok := a.taken[id]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (o *identifierAllocator) allocateIdentifier(want string) string {
+	id := want
+	for i := 2; ; i++ {
+		if !o.isTaken(id) {
+			o.taken[id] = struct{}{}
+			return id
+		}
+		id = want + "_" + strconv.Itoa(i)
+	}
+}
+
+// universe holds Go's predeclared identifiers, which generated code relies on
+// not being shadowed.
+var universe = newIdentifierAllocator(nil,
+	"bool", "byte", "complex64", "complex128", "error", "float32", "float64",
+	"int", "int8", "int16", "int32", "int64", "rune", "string",
+	"uint", "uint8", "uint16", "uint32", "uint64", "uintptr",
+	"true", "false", "iota", "nil",
+	"append", "cap", "close", "complex", "copy", "delete", "imag", "len",
+	"make", "new", "panic", "print", "println", "real", "recover",
+)
+
+// isMajorVersion returns whether the path element s is a major version
+// suffix, like v2.
+func isMajorVersion(s string) bool {
+	if len(s) < 2 || s[0] != 'v' {
+		return false
+	}
+	for _, r := range s[1:] {
+		if !unicode.IsDigit(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// sanitize cleans up a string to make a suitable package name.
+func sanitize(s string) string {
+	t := ""
+	for _, r := range s {
 		if t == "" {
 			if unicode.IsLetter(r) || r == '_' {
 				t += string(r)
@@ -447,6 +1624,49 @@ func sanitize(s string) string {
 	return t
 }
 
+// interfaces returns the interfaces to mock, in source order.
+func (g *Generator) interfaces() []*model.Interface {
+	var intfs []*model.Interface
+	for _, intf := range g.pkg.Interfaces {
+		if contains(g.c.Itf, intf.Name) {
+			intfs = append(intfs, intf)
+		}
+	}
+	return intfs
+}
+
+// mockFileName returns the name of the file the mock of the interface named
+// name is written to with a destination directory, e.g. user_service_mock.go
+// for UserService, or http_client_mock.go for HTTPClient.
+func mockFileName(name string) string {
+	rs := []rune(name)
+	var b strings.Builder
+	for i, r := range rs {
+		if i > 0 && unicode.IsUpper(r) {
+			prev := rs[i-1]
+			next := i+1 < len(rs) && unicode.IsLower(rs[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && next) {
+				b.WriteRune('_')
+			}
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String() + "_mock.go"
+}
+
+// sameDir returns whether files a and b are in the same directory.
+func sameDir(a, b string) bool {
+	da, err := filepath.Abs(filepath.Dir(a))
+	if err != nil {
+		return false
+	}
+	db, err := filepath.Abs(filepath.Dir(b))
+	if err != nil {
+		return false
+	}
+	return da == db
+}
+
 func contains(sl []string, s string) bool {
 	for _, e := range sl {
 		if e == s {
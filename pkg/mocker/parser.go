@@ -24,6 +24,7 @@ import (
 	"go/parser"
 	"go/token"
 	"log"
+	"os"
 	"path"
 	"path/filepath"
 	"strconv"
@@ -43,6 +44,7 @@ var (
 type Package struct {
 	*model.Package
 	PkgPath string
+	Names   map[string]string // import path to the package's name in the source file
 }
 
 func ParseFile(source string) (*Package, error) {
@@ -176,6 +178,15 @@ func (p *fileParser) parseFile(importPath string, file *ast.File) (*Package, err
 		}
 	}
 
+	names := make(map[string]string, len(p.imports))
+	for pkg, path := range p.imports {
+		names[path] = pkg
+	}
+	// the source file's own names win over other files' for the same path.
+	for pkg, path := range allImports {
+		names[path] = pkg
+	}
+
 	var is []*model.Interface
 	for ni := range iterInterfaces(file) {
 		i, err := p.parseInterface(ni.name.String(), importPath, ni.it)
@@ -191,9 +202,71 @@ func (p *fileParser) parseFile(importPath string, file *ast.File) (*Package, err
 			DotImports: dotImports,
 		},
 		PkgPath: importPath,
+		Names:   names,
 	}, nil
 }
 
+// packageNames returns the names of the packages at paths, as imported from
+// dir, leaving out those that fail to load.
+func packageNames(dir string, paths []string) map[string]string {
+	names := make(map[string]string, len(paths))
+	cfg := &packages.Config{Mode: packages.NeedName, Dir: dir}
+	pkgs, err := packages.Load(cfg, paths...)
+	if err != nil {
+		return names
+	}
+	for _, pkg := range pkgs {
+		if pkg.Name != "" && len(pkg.Errors) == 0 {
+			names[pkg.PkgPath] = pkg.Name
+		}
+	}
+	return names
+}
+
+// packageDecls returns the top level identifiers the files of package name in
+// dir declare, but for the file skip, which is about to be overwritten.
+func packageDecls(dir, name, skip string) ([]string, error) {
+	skip, err := filepath.Abs(skip)
+	if err != nil {
+		return nil, err
+	}
+	filter := func(fi os.FileInfo) bool {
+		path, err := filepath.Abs(filepath.Join(dir, fi.Name()))
+		return err != nil || path != skip
+	}
+	pkgs, err := parser.ParseDir(token.NewFileSet(), dir, filter, 0)
+	if err != nil {
+		return nil, err
+	}
+	pkg, ok := pkgs[name]
+	if !ok {
+		return nil, nil
+	}
+	var decls []string
+	for _, file := range pkg.Files {
+		for _, decl := range file.Decls {
+			switch decl := decl.(type) {
+			case *ast.FuncDecl:
+				if decl.Recv == nil {
+					decls = append(decls, decl.Name.Name)
+				}
+			case *ast.GenDecl:
+				for _, spec := range decl.Specs {
+					switch spec := spec.(type) {
+					case *ast.TypeSpec:
+						decls = append(decls, spec.Name.Name)
+					case *ast.ValueSpec:
+						for _, name := range spec.Names {
+							decls = append(decls, name.Name)
+						}
+					}
+				}
+			}
+		}
+	}
+	return decls, nil
+}
+
 // parsePackage loads package specified by path, parses it and populates
 // corresponding imports and importedInterfaces into the fileParser.
 func (p *fileParser) parsePackage(path string) error {
@@ -0,0 +1,118 @@
+package mocker
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/golang/mock/mockgen/model"
+)
+
+// TemplateData is what a template is executed with, once per interface mocked.
+type TemplateData struct {
+	// Interface is the parsed interface.
+	Interface *model.Interface
+	// Methods are the interface's methods in the configured order.
+	Methods []*model.Method
+	// Mock is the name of the mock's type, with its prefix and suffix.
+	Mock string
+	// Package is the name of the generated file's package.
+	Package string
+}
+
+// Scope allocates identifiers unique within the file, e.g. for a method's
+// params and locals.
+type Scope struct {
+	a *identifierAllocator
+}
+
+// Ident returns name, suffixed if it's taken.
+func (s *Scope) Ident(name string) string {
+	return s.a.allocateIdentifier(name)
+}
+
+// Args returns names for m's params, naming unnamed and blank ones after their
+// position.
+func (s *Scope) Args(m *model.Method) []string {
+	names := make([]string, len(m.In))
+	for i, p := range m.In {
+		names[i] = s.Ident(argName(p.Name, i))
+	}
+	if m.Variadic != nil {
+		names = append(names, s.Ident(argName(m.Variadic.Name, len(m.In))))
+	}
+	return names
+}
+
+// ParseTemplate parses a template mocks are generated with, in place of the
+// builtin mocks. Besides text/template's, it has the funcs:
+//
+//	type t            t, a model.Type, as written in the generated file
+//	qualify path name name, qualified with the package at path, which is imported
+//	ident name        name, suffixed if it's taken in the file, which it then is
+//	scope             a new *Scope, e.g. for a method's params and locals
+//	argTypes m        m's param types, e.g. ["int", "...string"]
+//	params m names    m's params, named names, e.g. "a int, b ...string"
+//	results m         m's results, e.g. "", "int" or "(int, error)"
+//	forward m names   args passing on m's params named names, e.g. "a, b..."
+//	join elems sep    strings.Join
+//	title s           s with its first letter upper cased
+func ParseTemplate(name, text string) (*template.Template, error) {
+	return template.New(name).Funcs(templateFuncs(nil)).Parse(text)
+}
+
+func templateFuncs(g *Generator) template.FuncMap {
+	return template.FuncMap{
+		"type":    g.Type,
+		"qualify": g.Qualify,
+		"ident":   g.Ident,
+		"scope":   g.NewScope,
+		"argTypes": func(m *model.Method) []string {
+			return g.getArgTypes(m)
+		},
+		"params": func(m *model.Method, names []string) string {
+			types := g.getArgTypes(m)
+			params := make([]string, len(types))
+			for i, t := range types {
+				params[i] = names[i] + " " + t
+			}
+			return strings.Join(params, ", ")
+		},
+		"results": func(m *model.Method) string {
+			rets := make([]string, len(m.Out))
+			for i, p := range m.Out {
+				rets[i] = g.Type(p.Type)
+			}
+			if len(rets) > 1 {
+				return "(" + strings.Join(rets, ", ") + ")"
+			}
+			return strings.Join(rets, "")
+		},
+		"forward": func(m *model.Method, names []string) string {
+			s := strings.Join(names, ", ")
+			if m.Variadic != nil {
+				s += "..."
+			}
+			return s
+		},
+		"join":  strings.Join,
+		"title": strings.Title,
+	}
+}
+
+// executeTemplate executes the template for each interface mocked.
+func (g *Generator) executeTemplate() error {
+	t := g.tmpl.Funcs(templateFuncs(g))
+	for _, intf := range g.interfaces() {
+		data := &TemplateData{
+			Interface: intf,
+			Methods:   g.methods(intf),
+			Mock:      g.typeName(intf.Name),
+			Package:   g.c.Pkg,
+		}
+		if err := t.Execute(&g.buf, data); err != nil {
+			return fmt.Errorf("failed executing template for %v: %v", intf.Name, err)
+		}
+	}
+	return nil
+}
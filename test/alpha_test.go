@@ -0,0 +1,83 @@
+package test
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// TestAlphaIfaceOrder checks the mock generated with --order=alpha has its
+// fields, calls, methods and resets sorted by method name, though Iface's
+// methods aren't.
+func TestAlphaIfaceOrder(t *testing.T) {
+	f, err := parser.ParseFile(token.NewFileSet(), "alpha.go", nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"Five", "Four", "One", "Three", "Two"}
+	var funcs, calls, methods, resets []string
+	for _, decl := range f.Decls {
+		switch decl := decl.(type) {
+		case *ast.GenDecl:
+			for _, spec := range decl.Specs {
+				ts, ok := spec.(*ast.TypeSpec)
+				if !ok || ts.Name.Name != "AlphaIface" {
+					continue
+				}
+				for _, field := range ts.Type.(*ast.StructType).Fields.List {
+					name := field.Names[0].Name
+					switch {
+					case strings.HasSuffix(name, "Func"):
+						funcs = append(funcs, strings.TrimSuffix(name, "Func"))
+					case name == "calls":
+						for _, call := range field.Type.(*ast.StructType).Fields.List {
+							calls = append(calls, call.Names[0].Name)
+						}
+					}
+				}
+			}
+		case *ast.FuncDecl:
+			if decl.Recv == nil {
+				continue
+			}
+			if contains(want, decl.Name.Name) {
+				methods = append(methods, decl.Name.Name)
+			}
+			if decl.Name.Name != "Reset" {
+				continue
+			}
+			for _, stmt := range decl.Body.List {
+				sel := stmt.(*ast.ExprStmt).X.(*ast.CallExpr).Fun.(*ast.SelectorExpr)
+				resets = append(resets, strings.TrimPrefix(sel.Sel.Name, "Reset"))
+			}
+		}
+	}
+
+	if !sort.StringsAreSorted(want) {
+		t.Fatalf("want %v isn't sorted", want)
+	}
+	for name, got := range map[string][]string{
+		"funcs":   funcs,
+		"calls":   calls,
+		"methods": methods,
+		"resets":  resets,
+	} {
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%v = %v, want %v", name, got, want)
+		}
+	}
+}
+
+func contains(ss []string, s string) bool {
+	for _, e := range ss {
+		if e == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,106 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/travisjeffery/mocker"
+)
+
+func TestClashVerify(t *testing.T) {
+	m := NewMockClash(t)
+	m.VerifyFunc = func(token string) error {
+		if token == "" {
+			return errors.New("no token")
+		}
+		return nil
+	}
+	if err := m.Verify("token"); err != nil {
+		t.Errorf("verify = %v, want nil", err)
+	}
+	// The mock's Verify is renamed rather than clashing.
+	if !m.Verify_2(t) {
+		t.Error("verify_2 = false, want true")
+	}
+}
+
+func TestClashZeroArgs(t *testing.T) {
+	m := NewMockClash(t).
+		WithClose(func() error { return nil }).
+		WithKeys(func() []string { return []string{"a"} })
+
+	ft := &fakeT{}
+	if m.AssertCloseCalledWith(ft) {
+		t.Error("assert close called with = true, want false")
+	}
+	m.Close()
+	if !m.AssertCloseCalledWith(t) {
+		t.Error("assert close called with = false, want true")
+	}
+	m.Keys()
+	m.Close()
+	if got := len(m.CloseCall().Seqs); got != 2 {
+		t.Errorf("close call seqs = %v, want %v", got, 2)
+	}
+}
+
+func TestClashCall(t *testing.T) {
+	m := NewMockClash(t).
+		WithDo(func() {}).
+		WithDoCall(func(id string) {})
+
+	m.Do()
+	m.DoCall("id")
+	// Do's Call helper is renamed rather than clashing with DoCall.
+	if !mocker.InOrder(t, m.DoCall_2(), m.DoCallCall("id")) {
+		t.Error("in order = false, want true")
+	}
+}
+
+func TestClashReset(t *testing.T) {
+	m := NewMockClash(t).
+		WithStubs(func() {}).
+		WithFoo(func(n int) {}).
+		WithResetFoo(func() {})
+
+	m.Stubs()
+	m.Foo(1)
+	m.ResetFoo()
+	// Foo's reset helper is renamed rather than clashing with ResetFoo.
+	m.ResetFoo_2()
+	if m.FooCalled() {
+		t.Error("foo called = true, want false")
+	}
+	if !m.ResetFooCalled() || !m.StubsCalled() {
+		t.Error("reset foo or stubs called = false, want true")
+	}
+	// The mock's own resets keep their names.
+	m.ResetStubs()
+	if m.StubsFunc != nil {
+		t.Error("stubs func not reset")
+	}
+	m.ResetAll()
+	if m.StubsCalled() || m.ResetFooCalled() {
+		t.Error("called = true after reset all, want false")
+	}
+}
+
+func TestClashWaitFor(t *testing.T) {
+	m := NewMockClash(t).
+		WithWait(func(ctx context.Context, n int) {}).
+		WithWaitForWait(func() {})
+
+	go m.Wait(context.Background(), 1)
+	// Wait's waiter is renamed rather than clashing with WaitForWait.
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := m.WaitForWait_2(ctx, 1); err != nil {
+		t.Fatalf("wait for wait_2 = %v, want nil", err)
+	}
+	m.WaitForWait()
+	if !m.WaitForWaitCalled() {
+		t.Error("wait for wait called = false, want true")
+	}
+}
@@ -0,0 +1,3 @@
+package dee
+
+type Int int
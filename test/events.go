@@ -0,0 +1,4806 @@
+// Code generated by mocker. DO NOT EDIT.
+// github.com/travisjeffery/mocker
+// Source: test/in.go
+
+package test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/travisjeffery/mocker"
+	"github.com/travisjeffery/mocker/pkg/mocker/match"
+	av1 "github.com/travisjeffery/mocker/test/a"
+	bv1 "github.com/travisjeffery/mocker/test/b"
+	"github.com/travisjeffery/mocker/test/c"
+)
+
+// EventsIface is a mock of Iface interface
+type EventsIface struct {
+	lockOne sync.Mutex
+	OneFunc EventsIfaceOneFunc
+
+	lockTwo sync.Mutex
+	TwoFunc EventsIfaceTwoFunc
+
+	lockThree sync.Mutex
+	ThreeFunc EventsIfaceThreeFunc
+
+	lockFour sync.Mutex
+	FourFunc EventsIfaceFourFunc
+
+	lockFive sync.Mutex
+	FiveFunc EventsIfaceFiveFunc
+
+	calls struct {
+		One   []*EventsIfaceOneCall
+		Two   []*EventsIfaceTwoCall
+		Three []*EventsIfaceThreeCall
+		Four  []*EventsIfaceFourCall
+		Five  []*EventsIfaceFiveCall
+	}
+	seqs struct {
+		One   []uint64
+		Two   []uint64
+		Three []uint64
+		Four  []uint64
+		Five  []uint64
+	}
+	conds struct {
+		One   *sync.Cond
+		Two   *sync.Cond
+		Three *sync.Cond
+		Four  *sync.Cond
+		Five  *sync.Cond
+	}
+	onCalls struct {
+		One   map[int]EventsIfaceOneFunc
+		Two   map[int]EventsIfaceTwoFunc
+		Three map[int]EventsIfaceThreeFunc
+		Four  map[int]EventsIfaceFourFunc
+		Five  map[int]EventsIfaceFiveFunc
+	}
+	queued struct {
+		One   []EventsIfaceOneFunc
+		Two   []EventsIfaceTwoFunc
+		Three []EventsIfaceThreeFunc
+		Four  []EventsIfaceFourFunc
+		Five  []EventsIfaceFiveFunc
+	}
+	unstubbed struct {
+		One   int
+		Two   int
+		Three int
+		Four  int
+		Five  int
+	}
+	events mocker.Events
+	t      testing.TB
+}
+
+// EventsIface must implement Iface, so the build breaks if it's stale.
+var _ Iface = (*EventsIface)(nil)
+
+// NewEventsIface returns a EventsIface that reports calls to methods without a
+// func through t, rather than panicking, and verifies it was used as
+// stubbed when the test's done.
+func NewEventsIface(t testing.TB) *EventsIface {
+	m := &EventsIface{t: t}
+	t.Cleanup(func() {
+		m.Verify(t)
+		m.events.Close()
+	})
+	return m
+}
+
+// EventsIfaceOneFunc is the func EventsIface.One calls.
+type EventsIfaceOneFunc func(str string, variadic ...string) (string, []string)
+
+// EventsIfaceOneCall is a call made to EventsIface.One.
+type EventsIfaceOneCall struct {
+	Str      string
+	Variadic []string
+
+	Ret0 string
+	Ret1 []string
+
+	// Panic is the value the call panicked with, if it did.
+	Panic interface{}
+}
+
+// One mocks base method by wrapping the associated func.
+func (m *EventsIface) One(str string, variadic ...string) (string, []string) {
+	m.lockOne.Lock()
+	fn := m.OneFunc
+	if onCall, ok := m.onCalls.One[len(m.calls.One)]; ok {
+		fn = onCall
+		delete(m.onCalls.One, len(m.calls.One))
+	} else if len(m.queued.One) > 0 {
+		fn = m.queued.One[0]
+		m.queued.One = m.queued.One[1:]
+	}
+	if fn == nil {
+		if m.t == nil {
+			m.lockOne.Unlock()
+			panic("mocker: EventsIface.OneFunc is nil but EventsIface.One was called.")
+		}
+		m.unstubbed.One++
+	}
+	call := &EventsIfaceOneCall{
+		Str:      str,
+		Variadic: variadic,
+	}
+	m.calls.One = append(m.calls.One, call)
+	m.seqs.One = append(m.seqs.One, mocker.Sequence())
+	if m.conds.One != nil {
+		m.conds.One.Broadcast()
+	}
+	m.lockOne.Unlock()
+
+	var ret0 string
+	var ret1 []string
+	defer func() {
+		r := recover()
+		m.lockOne.Lock()
+		call.Ret0 = ret0
+		call.Ret1 = ret1
+		call.Panic = r
+		m.lockOne.Unlock()
+		m.events.Publish(mocker.CallEvent{
+			Mock:    "EventsIface",
+			Method:  "One",
+			Args:    []interface{}{str, variadic},
+			Results: []interface{}{ret0, ret1},
+			Panic:   r,
+		})
+		if r != nil {
+			panic(r)
+		}
+	}()
+
+	if fn == nil {
+		return ret0, ret1
+	}
+
+	ret0, ret1 = fn(str, variadic...)
+	return ret0, ret1
+}
+
+// OneCalled returns true if One was called at least once.
+func (m *EventsIface) OneCalled() bool {
+	m.lockOne.Lock()
+	defer m.lockOne.Unlock()
+
+	return len(m.calls.One) > 0
+}
+
+// OneCalls returns the calls made to One.
+func (m *EventsIface) OneCalls() []EventsIfaceOneCall {
+	m.lockOne.Lock()
+	defer m.lockOne.Unlock()
+
+	var calls []EventsIfaceOneCall
+	for _, call := range m.calls.One {
+		calls = append(calls, *call)
+	}
+	return calls
+}
+
+// OneCallCount returns the number of calls made to One.
+func (m *EventsIface) OneCallCount() int {
+	m.lockOne.Lock()
+	defer m.lockOne.Unlock()
+
+	return len(m.calls.One)
+}
+
+// OneCallAt returns the i'th call made to One, counting from 0, failing the
+// test through t if there weren't that many calls.
+func (m *EventsIface) OneCallAt(t testing.TB, i int) EventsIfaceOneCall {
+	t.Helper()
+	m.lockOne.Lock()
+	defer m.lockOne.Unlock()
+
+	if i < 0 || i >= len(m.calls.One) {
+		t.Fatalf("mocker: EventsIface.One was called %v times, wanted call %v", len(m.calls.One), i)
+	}
+	return *m.calls.One[i]
+}
+
+// OneLastCall returns the last call made to One, failing the test through t
+// if there weren't any.
+func (m *EventsIface) OneLastCall(t testing.TB) EventsIfaceOneCall {
+	t.Helper()
+	m.lockOne.Lock()
+	defer m.lockOne.Unlock()
+
+	if len(m.calls.One) == 0 {
+		t.Fatalf("mocker: EventsIface.One wasn't called")
+	}
+	return *m.calls.One[len(m.calls.One)-1]
+}
+
+// OneCallsWhere returns the calls made to One that fn returns true for.
+func (m *EventsIface) OneCallsWhere(fn func(EventsIfaceOneCall) bool) []EventsIfaceOneCall {
+	var calls []EventsIfaceOneCall
+	for _, call := range m.OneCalls() {
+		if fn(call) {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// AssertOneCalledWith reports through t unless One was called with args
+// matching the matchers, and returns whether it was.
+func (m *EventsIface) AssertOneCalledWith(t testing.TB, str, variadic match.Matcher) bool {
+	t.Helper()
+	m.lockOne.Lock()
+	defer m.lockOne.Unlock()
+
+	for _, call := range m.calls.One {
+		if str.Match(call.Str) && variadic.Match(call.Variadic) {
+			return true
+		}
+	}
+	t.Errorf("mocker: EventsIface.One wasn't called with (%v, %v)", str, variadic)
+	return false
+}
+
+// OneCall describes the calls made to One with args matching args, which
+// may be match.Matchers, for asserting on their order with mocker.InOrder.
+func (m *EventsIface) OneCall(str, variadic interface{}) mocker.Call {
+	m.lockOne.Lock()
+	defer m.lockOne.Unlock()
+
+	var seqs []uint64
+	for i, call := range m.calls.One {
+		if match.Of(str).Match(call.Str) && match.Of(variadic).Match(call.Variadic) {
+			seqs = append(seqs, m.seqs.One[i])
+		}
+	}
+	return mocker.Call{
+		Desc: mocker.Describe("EventsIface.One", str, variadic),
+		Seqs: seqs,
+	}
+}
+
+// WaitForOne blocks until One has been called at least n times, returning
+// nil, or until ctx is done, returning its error.
+func (m *EventsIface) WaitForOne(ctx context.Context, n int) error {
+	m.lockOne.Lock()
+	defer m.lockOne.Unlock()
+
+	if m.conds.One == nil {
+		m.conds.One = sync.NewCond(&m.lockOne)
+	}
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		select {
+		case <-ctx.Done():
+			m.lockOne.Lock()
+			m.conds.One.Broadcast()
+			m.lockOne.Unlock()
+		case <-stop:
+		}
+	}()
+	for len(m.calls.One) < n {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		m.conds.One.Wait()
+	}
+	return nil
+}
+
+// ResetOne resets the calls made to One. Stubs set with OnOneCall for calls
+// yet to be made keep their calls, now counted from the reset.
+func (m *EventsIface) ResetOne() {
+	m.lockOne.Lock()
+	defer m.lockOne.Unlock()
+
+	if n := len(m.calls.One); n > 0 && m.onCalls.One != nil {
+		onCalls := make(map[int]EventsIfaceOneFunc, len(m.onCalls.One))
+		for i, fn := range m.onCalls.One {
+			if i >= n {
+				onCalls[i-n] = fn
+			}
+		}
+		m.onCalls.One = onCalls
+	}
+	m.calls.One = nil
+	m.seqs.One = nil
+	m.unstubbed.One = 0
+}
+
+// OnOneCall makes the n'th call to One, counting from 0 like OneCalls, call fn
+// rather than OneFunc.
+func (m *EventsIface) OnOneCall(n int, fn EventsIfaceOneFunc) {
+	m.lockOne.Lock()
+	defer m.lockOne.Unlock()
+
+	if m.onCalls.One == nil {
+		m.onCalls.One = make(map[int]EventsIfaceOneFunc)
+	}
+	m.onCalls.One[n] = fn
+}
+
+// OneReturnsSequence queues fns for the next calls to One to call in turn, one
+// per call, after any queued before, then falling back to OneFunc. Calls
+// stubbed with OnOneCall don't use up the queue.
+func (m *EventsIface) OneReturnsSequence(fns ...EventsIfaceOneFunc) {
+	m.lockOne.Lock()
+	defer m.lockOne.Unlock()
+
+	m.queued.One = append(m.queued.One, fns...)
+}
+
+// WithOne sets OneFunc to fn, taking the lock calls to One take so it's safe
+// once the mock's shared, and returns the mock.
+func (m *EventsIface) WithOne(fn EventsIfaceOneFunc) *EventsIface {
+	m.lockOne.Lock()
+	m.OneFunc = fn
+	m.lockOne.Unlock()
+	return m
+}
+
+// EventsIfaceTwoFunc is the func EventsIface.Two calls.
+type EventsIfaceTwoFunc func(arg0, arg1 int) int
+
+// EventsIfaceTwoCall is a call made to EventsIface.Two.
+type EventsIfaceTwoCall struct {
+	Arg0 int
+	Arg1 int
+
+	Ret0 int
+
+	// Panic is the value the call panicked with, if it did.
+	Panic interface{}
+}
+
+// Two mocks base method by wrapping the associated func.
+func (m *EventsIface) Two(arg0, arg1 int) int {
+	m.lockTwo.Lock()
+	fn := m.TwoFunc
+	if onCall, ok := m.onCalls.Two[len(m.calls.Two)]; ok {
+		fn = onCall
+		delete(m.onCalls.Two, len(m.calls.Two))
+	} else if len(m.queued.Two) > 0 {
+		fn = m.queued.Two[0]
+		m.queued.Two = m.queued.Two[1:]
+	}
+	if fn == nil {
+		if m.t == nil {
+			m.lockTwo.Unlock()
+			panic("mocker: EventsIface.TwoFunc is nil but EventsIface.Two was called.")
+		}
+		m.unstubbed.Two++
+	}
+	call := &EventsIfaceTwoCall{
+		Arg0: arg0,
+		Arg1: arg1,
+	}
+	m.calls.Two = append(m.calls.Two, call)
+	m.seqs.Two = append(m.seqs.Two, mocker.Sequence())
+	if m.conds.Two != nil {
+		m.conds.Two.Broadcast()
+	}
+	m.lockTwo.Unlock()
+
+	var ret0 int
+	defer func() {
+		r := recover()
+		m.lockTwo.Lock()
+		call.Ret0 = ret0
+		call.Panic = r
+		m.lockTwo.Unlock()
+		m.events.Publish(mocker.CallEvent{
+			Mock:    "EventsIface",
+			Method:  "Two",
+			Args:    []interface{}{arg0, arg1},
+			Results: []interface{}{ret0},
+			Panic:   r,
+		})
+		if r != nil {
+			panic(r)
+		}
+	}()
+
+	if fn == nil {
+		return ret0
+	}
+
+	ret0 = fn(arg0, arg1)
+	return ret0
+}
+
+// TwoCalled returns true if Two was called at least once.
+func (m *EventsIface) TwoCalled() bool {
+	m.lockTwo.Lock()
+	defer m.lockTwo.Unlock()
+
+	return len(m.calls.Two) > 0
+}
+
+// TwoCalls returns the calls made to Two.
+func (m *EventsIface) TwoCalls() []EventsIfaceTwoCall {
+	m.lockTwo.Lock()
+	defer m.lockTwo.Unlock()
+
+	var calls []EventsIfaceTwoCall
+	for _, call := range m.calls.Two {
+		calls = append(calls, *call)
+	}
+	return calls
+}
+
+// TwoCallCount returns the number of calls made to Two.
+func (m *EventsIface) TwoCallCount() int {
+	m.lockTwo.Lock()
+	defer m.lockTwo.Unlock()
+
+	return len(m.calls.Two)
+}
+
+// TwoCallAt returns the i'th call made to Two, counting from 0, failing the
+// test through t if there weren't that many calls.
+func (m *EventsIface) TwoCallAt(t testing.TB, i int) EventsIfaceTwoCall {
+	t.Helper()
+	m.lockTwo.Lock()
+	defer m.lockTwo.Unlock()
+
+	if i < 0 || i >= len(m.calls.Two) {
+		t.Fatalf("mocker: EventsIface.Two was called %v times, wanted call %v", len(m.calls.Two), i)
+	}
+	return *m.calls.Two[i]
+}
+
+// TwoLastCall returns the last call made to Two, failing the test through t
+// if there weren't any.
+func (m *EventsIface) TwoLastCall(t testing.TB) EventsIfaceTwoCall {
+	t.Helper()
+	m.lockTwo.Lock()
+	defer m.lockTwo.Unlock()
+
+	if len(m.calls.Two) == 0 {
+		t.Fatalf("mocker: EventsIface.Two wasn't called")
+	}
+	return *m.calls.Two[len(m.calls.Two)-1]
+}
+
+// TwoCallsWhere returns the calls made to Two that fn returns true for.
+func (m *EventsIface) TwoCallsWhere(fn func(EventsIfaceTwoCall) bool) []EventsIfaceTwoCall {
+	var calls []EventsIfaceTwoCall
+	for _, call := range m.TwoCalls() {
+		if fn(call) {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// AssertTwoCalledWith reports through t unless Two was called with args
+// matching the matchers, and returns whether it was.
+func (m *EventsIface) AssertTwoCalledWith(t testing.TB, arg0, arg1 match.Matcher) bool {
+	t.Helper()
+	m.lockTwo.Lock()
+	defer m.lockTwo.Unlock()
+
+	for _, call := range m.calls.Two {
+		if arg0.Match(call.Arg0) && arg1.Match(call.Arg1) {
+			return true
+		}
+	}
+	t.Errorf("mocker: EventsIface.Two wasn't called with (%v, %v)", arg0, arg1)
+	return false
+}
+
+// TwoCall describes the calls made to Two with args matching args, which
+// may be match.Matchers, for asserting on their order with mocker.InOrder.
+func (m *EventsIface) TwoCall(arg0, arg1 interface{}) mocker.Call {
+	m.lockTwo.Lock()
+	defer m.lockTwo.Unlock()
+
+	var seqs []uint64
+	for i, call := range m.calls.Two {
+		if match.Of(arg0).Match(call.Arg0) && match.Of(arg1).Match(call.Arg1) {
+			seqs = append(seqs, m.seqs.Two[i])
+		}
+	}
+	return mocker.Call{
+		Desc: mocker.Describe("EventsIface.Two", arg0, arg1),
+		Seqs: seqs,
+	}
+}
+
+// WaitForTwo blocks until Two has been called at least n times, returning
+// nil, or until ctx is done, returning its error.
+func (m *EventsIface) WaitForTwo(ctx context.Context, n int) error {
+	m.lockTwo.Lock()
+	defer m.lockTwo.Unlock()
+
+	if m.conds.Two == nil {
+		m.conds.Two = sync.NewCond(&m.lockTwo)
+	}
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		select {
+		case <-ctx.Done():
+			m.lockTwo.Lock()
+			m.conds.Two.Broadcast()
+			m.lockTwo.Unlock()
+		case <-stop:
+		}
+	}()
+	for len(m.calls.Two) < n {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		m.conds.Two.Wait()
+	}
+	return nil
+}
+
+// ResetTwo resets the calls made to Two. Stubs set with OnTwoCall for calls
+// yet to be made keep their calls, now counted from the reset.
+func (m *EventsIface) ResetTwo() {
+	m.lockTwo.Lock()
+	defer m.lockTwo.Unlock()
+
+	if n := len(m.calls.Two); n > 0 && m.onCalls.Two != nil {
+		onCalls := make(map[int]EventsIfaceTwoFunc, len(m.onCalls.Two))
+		for i, fn := range m.onCalls.Two {
+			if i >= n {
+				onCalls[i-n] = fn
+			}
+		}
+		m.onCalls.Two = onCalls
+	}
+	m.calls.Two = nil
+	m.seqs.Two = nil
+	m.unstubbed.Two = 0
+}
+
+// OnTwoCall makes the n'th call to Two, counting from 0 like TwoCalls, call fn
+// rather than TwoFunc.
+func (m *EventsIface) OnTwoCall(n int, fn EventsIfaceTwoFunc) {
+	m.lockTwo.Lock()
+	defer m.lockTwo.Unlock()
+
+	if m.onCalls.Two == nil {
+		m.onCalls.Two = make(map[int]EventsIfaceTwoFunc)
+	}
+	m.onCalls.Two[n] = fn
+}
+
+// TwoReturnsSequence queues fns for the next calls to Two to call in turn, one
+// per call, after any queued before, then falling back to TwoFunc. Calls
+// stubbed with OnTwoCall don't use up the queue.
+func (m *EventsIface) TwoReturnsSequence(fns ...EventsIfaceTwoFunc) {
+	m.lockTwo.Lock()
+	defer m.lockTwo.Unlock()
+
+	m.queued.Two = append(m.queued.Two, fns...)
+}
+
+// WithTwo sets TwoFunc to fn, taking the lock calls to Two take so it's safe
+// once the mock's shared, and returns the mock.
+func (m *EventsIface) WithTwo(fn EventsIfaceTwoFunc) *EventsIface {
+	m.lockTwo.Lock()
+	m.TwoFunc = fn
+	m.lockTwo.Unlock()
+	return m
+}
+
+// EventsIfaceThreeFunc is the func EventsIface.Three calls.
+type EventsIfaceThreeFunc func(arg0 av1.Int) bv1.Str
+
+// EventsIfaceThreeCall is a call made to EventsIface.Three.
+type EventsIfaceThreeCall struct {
+	Arg0 av1.Int
+
+	Ret0 bv1.Str
+
+	// Panic is the value the call panicked with, if it did.
+	Panic interface{}
+}
+
+// Three mocks base method by wrapping the associated func.
+func (m *EventsIface) Three(arg0 av1.Int) bv1.Str {
+	m.lockThree.Lock()
+	fn := m.ThreeFunc
+	if onCall, ok := m.onCalls.Three[len(m.calls.Three)]; ok {
+		fn = onCall
+		delete(m.onCalls.Three, len(m.calls.Three))
+	} else if len(m.queued.Three) > 0 {
+		fn = m.queued.Three[0]
+		m.queued.Three = m.queued.Three[1:]
+	}
+	if fn == nil {
+		if m.t == nil {
+			m.lockThree.Unlock()
+			panic("mocker: EventsIface.ThreeFunc is nil but EventsIface.Three was called.")
+		}
+		m.unstubbed.Three++
+	}
+	call := &EventsIfaceThreeCall{
+		Arg0: arg0,
+	}
+	m.calls.Three = append(m.calls.Three, call)
+	m.seqs.Three = append(m.seqs.Three, mocker.Sequence())
+	if m.conds.Three != nil {
+		m.conds.Three.Broadcast()
+	}
+	m.lockThree.Unlock()
+
+	var ret0 bv1.Str
+	defer func() {
+		r := recover()
+		m.lockThree.Lock()
+		call.Ret0 = ret0
+		call.Panic = r
+		m.lockThree.Unlock()
+		m.events.Publish(mocker.CallEvent{
+			Mock:    "EventsIface",
+			Method:  "Three",
+			Args:    []interface{}{arg0},
+			Results: []interface{}{ret0},
+			Panic:   r,
+		})
+		if r != nil {
+			panic(r)
+		}
+	}()
+
+	if fn == nil {
+		return ret0
+	}
+
+	ret0 = fn(arg0)
+	return ret0
+}
+
+// ThreeCalled returns true if Three was called at least once.
+func (m *EventsIface) ThreeCalled() bool {
+	m.lockThree.Lock()
+	defer m.lockThree.Unlock()
+
+	return len(m.calls.Three) > 0
+}
+
+// ThreeCalls returns the calls made to Three.
+func (m *EventsIface) ThreeCalls() []EventsIfaceThreeCall {
+	m.lockThree.Lock()
+	defer m.lockThree.Unlock()
+
+	var calls []EventsIfaceThreeCall
+	for _, call := range m.calls.Three {
+		calls = append(calls, *call)
+	}
+	return calls
+}
+
+// ThreeCallCount returns the number of calls made to Three.
+func (m *EventsIface) ThreeCallCount() int {
+	m.lockThree.Lock()
+	defer m.lockThree.Unlock()
+
+	return len(m.calls.Three)
+}
+
+// ThreeCallAt returns the i'th call made to Three, counting from 0, failing the
+// test through t if there weren't that many calls.
+func (m *EventsIface) ThreeCallAt(t testing.TB, i int) EventsIfaceThreeCall {
+	t.Helper()
+	m.lockThree.Lock()
+	defer m.lockThree.Unlock()
+
+	if i < 0 || i >= len(m.calls.Three) {
+		t.Fatalf("mocker: EventsIface.Three was called %v times, wanted call %v", len(m.calls.Three), i)
+	}
+	return *m.calls.Three[i]
+}
+
+// ThreeLastCall returns the last call made to Three, failing the test through t
+// if there weren't any.
+func (m *EventsIface) ThreeLastCall(t testing.TB) EventsIfaceThreeCall {
+	t.Helper()
+	m.lockThree.Lock()
+	defer m.lockThree.Unlock()
+
+	if len(m.calls.Three) == 0 {
+		t.Fatalf("mocker: EventsIface.Three wasn't called")
+	}
+	return *m.calls.Three[len(m.calls.Three)-1]
+}
+
+// ThreeCallsWhere returns the calls made to Three that fn returns true for.
+func (m *EventsIface) ThreeCallsWhere(fn func(EventsIfaceThreeCall) bool) []EventsIfaceThreeCall {
+	var calls []EventsIfaceThreeCall
+	for _, call := range m.ThreeCalls() {
+		if fn(call) {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// AssertThreeCalledWith reports through t unless Three was called with args
+// matching the matchers, and returns whether it was.
+func (m *EventsIface) AssertThreeCalledWith(t testing.TB, arg0 match.Matcher) bool {
+	t.Helper()
+	m.lockThree.Lock()
+	defer m.lockThree.Unlock()
+
+	for _, call := range m.calls.Three {
+		if arg0.Match(call.Arg0) {
+			return true
+		}
+	}
+	t.Errorf("mocker: EventsIface.Three wasn't called with (%v)", arg0)
+	return false
+}
+
+// ThreeCall describes the calls made to Three with args matching args, which
+// may be match.Matchers, for asserting on their order with mocker.InOrder.
+func (m *EventsIface) ThreeCall(arg0 interface{}) mocker.Call {
+	m.lockThree.Lock()
+	defer m.lockThree.Unlock()
+
+	var seqs []uint64
+	for i, call := range m.calls.Three {
+		if match.Of(arg0).Match(call.Arg0) {
+			seqs = append(seqs, m.seqs.Three[i])
+		}
+	}
+	return mocker.Call{
+		Desc: mocker.Describe("EventsIface.Three", arg0),
+		Seqs: seqs,
+	}
+}
+
+// WaitForThree blocks until Three has been called at least n times, returning
+// nil, or until ctx is done, returning its error.
+func (m *EventsIface) WaitForThree(ctx context.Context, n int) error {
+	m.lockThree.Lock()
+	defer m.lockThree.Unlock()
+
+	if m.conds.Three == nil {
+		m.conds.Three = sync.NewCond(&m.lockThree)
+	}
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		select {
+		case <-ctx.Done():
+			m.lockThree.Lock()
+			m.conds.Three.Broadcast()
+			m.lockThree.Unlock()
+		case <-stop:
+		}
+	}()
+	for len(m.calls.Three) < n {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		m.conds.Three.Wait()
+	}
+	return nil
+}
+
+// ResetThree resets the calls made to Three. Stubs set with OnThreeCall for calls
+// yet to be made keep their calls, now counted from the reset.
+func (m *EventsIface) ResetThree() {
+	m.lockThree.Lock()
+	defer m.lockThree.Unlock()
+
+	if n := len(m.calls.Three); n > 0 && m.onCalls.Three != nil {
+		onCalls := make(map[int]EventsIfaceThreeFunc, len(m.onCalls.Three))
+		for i, fn := range m.onCalls.Three {
+			if i >= n {
+				onCalls[i-n] = fn
+			}
+		}
+		m.onCalls.Three = onCalls
+	}
+	m.calls.Three = nil
+	m.seqs.Three = nil
+	m.unstubbed.Three = 0
+}
+
+// OnThreeCall makes the n'th call to Three, counting from 0 like ThreeCalls, call fn
+// rather than ThreeFunc.
+func (m *EventsIface) OnThreeCall(n int, fn EventsIfaceThreeFunc) {
+	m.lockThree.Lock()
+	defer m.lockThree.Unlock()
+
+	if m.onCalls.Three == nil {
+		m.onCalls.Three = make(map[int]EventsIfaceThreeFunc)
+	}
+	m.onCalls.Three[n] = fn
+}
+
+// ThreeReturnsSequence queues fns for the next calls to Three to call in turn, one
+// per call, after any queued before, then falling back to ThreeFunc. Calls
+// stubbed with OnThreeCall don't use up the queue.
+func (m *EventsIface) ThreeReturnsSequence(fns ...EventsIfaceThreeFunc) {
+	m.lockThree.Lock()
+	defer m.lockThree.Unlock()
+
+	m.queued.Three = append(m.queued.Three, fns...)
+}
+
+// WithThree sets ThreeFunc to fn, taking the lock calls to Three take so it's safe
+// once the mock's shared, and returns the mock.
+func (m *EventsIface) WithThree(fn EventsIfaceThreeFunc) *EventsIface {
+	m.lockThree.Lock()
+	m.ThreeFunc = fn
+	m.lockThree.Unlock()
+	return m
+}
+
+// EventsIfaceFourFunc is the func EventsIface.Four calls.
+type EventsIfaceFourFunc func(arg0 c.Int)
+
+// EventsIfaceFourCall is a call made to EventsIface.Four.
+type EventsIfaceFourCall struct {
+	Arg0 c.Int
+
+	// Panic is the value the call panicked with, if it did.
+	Panic interface{}
+}
+
+// Four mocks base method by wrapping the associated func.
+func (m *EventsIface) Four(arg0 c.Int) {
+	m.lockFour.Lock()
+	fn := m.FourFunc
+	if onCall, ok := m.onCalls.Four[len(m.calls.Four)]; ok {
+		fn = onCall
+		delete(m.onCalls.Four, len(m.calls.Four))
+	} else if len(m.queued.Four) > 0 {
+		fn = m.queued.Four[0]
+		m.queued.Four = m.queued.Four[1:]
+	}
+	if fn == nil {
+		if m.t == nil {
+			m.lockFour.Unlock()
+			panic("mocker: EventsIface.FourFunc is nil but EventsIface.Four was called.")
+		}
+		m.unstubbed.Four++
+	}
+	call := &EventsIfaceFourCall{
+		Arg0: arg0,
+	}
+	m.calls.Four = append(m.calls.Four, call)
+	m.seqs.Four = append(m.seqs.Four, mocker.Sequence())
+	if m.conds.Four != nil {
+		m.conds.Four.Broadcast()
+	}
+	m.lockFour.Unlock()
+
+	defer func() {
+		r := recover()
+		m.lockFour.Lock()
+		call.Panic = r
+		m.lockFour.Unlock()
+		m.events.Publish(mocker.CallEvent{
+			Mock:    "EventsIface",
+			Method:  "Four",
+			Args:    []interface{}{arg0},
+			Results: []interface{}{},
+			Panic:   r,
+		})
+		if r != nil {
+			panic(r)
+		}
+	}()
+
+	if fn == nil {
+		return
+	}
+
+	fn(arg0)
+}
+
+// FourCalled returns true if Four was called at least once.
+func (m *EventsIface) FourCalled() bool {
+	m.lockFour.Lock()
+	defer m.lockFour.Unlock()
+
+	return len(m.calls.Four) > 0
+}
+
+// FourCalls returns the calls made to Four.
+func (m *EventsIface) FourCalls() []EventsIfaceFourCall {
+	m.lockFour.Lock()
+	defer m.lockFour.Unlock()
+
+	var calls []EventsIfaceFourCall
+	for _, call := range m.calls.Four {
+		calls = append(calls, *call)
+	}
+	return calls
+}
+
+// FourCallCount returns the number of calls made to Four.
+func (m *EventsIface) FourCallCount() int {
+	m.lockFour.Lock()
+	defer m.lockFour.Unlock()
+
+	return len(m.calls.Four)
+}
+
+// FourCallAt returns the i'th call made to Four, counting from 0, failing the
+// test through t if there weren't that many calls.
+func (m *EventsIface) FourCallAt(t testing.TB, i int) EventsIfaceFourCall {
+	t.Helper()
+	m.lockFour.Lock()
+	defer m.lockFour.Unlock()
+
+	if i < 0 || i >= len(m.calls.Four) {
+		t.Fatalf("mocker: EventsIface.Four was called %v times, wanted call %v", len(m.calls.Four), i)
+	}
+	return *m.calls.Four[i]
+}
+
+// FourLastCall returns the last call made to Four, failing the test through t
+// if there weren't any.
+func (m *EventsIface) FourLastCall(t testing.TB) EventsIfaceFourCall {
+	t.Helper()
+	m.lockFour.Lock()
+	defer m.lockFour.Unlock()
+
+	if len(m.calls.Four) == 0 {
+		t.Fatalf("mocker: EventsIface.Four wasn't called")
+	}
+	return *m.calls.Four[len(m.calls.Four)-1]
+}
+
+// FourCallsWhere returns the calls made to Four that fn returns true for.
+func (m *EventsIface) FourCallsWhere(fn func(EventsIfaceFourCall) bool) []EventsIfaceFourCall {
+	var calls []EventsIfaceFourCall
+	for _, call := range m.FourCalls() {
+		if fn(call) {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// AssertFourCalledWith reports through t unless Four was called with args
+// matching the matchers, and returns whether it was.
+func (m *EventsIface) AssertFourCalledWith(t testing.TB, arg0 match.Matcher) bool {
+	t.Helper()
+	m.lockFour.Lock()
+	defer m.lockFour.Unlock()
+
+	for _, call := range m.calls.Four {
+		if arg0.Match(call.Arg0) {
+			return true
+		}
+	}
+	t.Errorf("mocker: EventsIface.Four wasn't called with (%v)", arg0)
+	return false
+}
+
+// FourCall describes the calls made to Four with args matching args, which
+// may be match.Matchers, for asserting on their order with mocker.InOrder.
+func (m *EventsIface) FourCall(arg0 interface{}) mocker.Call {
+	m.lockFour.Lock()
+	defer m.lockFour.Unlock()
+
+	var seqs []uint64
+	for i, call := range m.calls.Four {
+		if match.Of(arg0).Match(call.Arg0) {
+			seqs = append(seqs, m.seqs.Four[i])
+		}
+	}
+	return mocker.Call{
+		Desc: mocker.Describe("EventsIface.Four", arg0),
+		Seqs: seqs,
+	}
+}
+
+// WaitForFour blocks until Four has been called at least n times, returning
+// nil, or until ctx is done, returning its error.
+func (m *EventsIface) WaitForFour(ctx context.Context, n int) error {
+	m.lockFour.Lock()
+	defer m.lockFour.Unlock()
+
+	if m.conds.Four == nil {
+		m.conds.Four = sync.NewCond(&m.lockFour)
+	}
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		select {
+		case <-ctx.Done():
+			m.lockFour.Lock()
+			m.conds.Four.Broadcast()
+			m.lockFour.Unlock()
+		case <-stop:
+		}
+	}()
+	for len(m.calls.Four) < n {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		m.conds.Four.Wait()
+	}
+	return nil
+}
+
+// ResetFour resets the calls made to Four. Stubs set with OnFourCall for calls
+// yet to be made keep their calls, now counted from the reset.
+func (m *EventsIface) ResetFour() {
+	m.lockFour.Lock()
+	defer m.lockFour.Unlock()
+
+	if n := len(m.calls.Four); n > 0 && m.onCalls.Four != nil {
+		onCalls := make(map[int]EventsIfaceFourFunc, len(m.onCalls.Four))
+		for i, fn := range m.onCalls.Four {
+			if i >= n {
+				onCalls[i-n] = fn
+			}
+		}
+		m.onCalls.Four = onCalls
+	}
+	m.calls.Four = nil
+	m.seqs.Four = nil
+	m.unstubbed.Four = 0
+}
+
+// OnFourCall makes the n'th call to Four, counting from 0 like FourCalls, call fn
+// rather than FourFunc.
+func (m *EventsIface) OnFourCall(n int, fn EventsIfaceFourFunc) {
+	m.lockFour.Lock()
+	defer m.lockFour.Unlock()
+
+	if m.onCalls.Four == nil {
+		m.onCalls.Four = make(map[int]EventsIfaceFourFunc)
+	}
+	m.onCalls.Four[n] = fn
+}
+
+// FourReturnsSequence queues fns for the next calls to Four to call in turn, one
+// per call, after any queued before, then falling back to FourFunc. Calls
+// stubbed with OnFourCall don't use up the queue.
+func (m *EventsIface) FourReturnsSequence(fns ...EventsIfaceFourFunc) {
+	m.lockFour.Lock()
+	defer m.lockFour.Unlock()
+
+	m.queued.Four = append(m.queued.Four, fns...)
+}
+
+// WithFour sets FourFunc to fn, taking the lock calls to Four take so it's safe
+// once the mock's shared, and returns the mock.
+func (m *EventsIface) WithFour(fn EventsIfaceFourFunc) *EventsIface {
+	m.lockFour.Lock()
+	m.FourFunc = fn
+	m.lockFour.Unlock()
+	return m
+}
+
+// EventsIfaceFiveFunc is the func EventsIface.Five calls.
+type EventsIfaceFiveFunc func(ctx context.Context, id string) (int, error)
+
+// EventsIfaceFiveCall is a call made to EventsIface.Five.
+type EventsIfaceFiveCall struct {
+	Ctx context.Context
+	Id  string
+
+	Ret0 int
+	Ret1 error
+
+	// Panic is the value the call panicked with, if it did.
+	Panic interface{}
+}
+
+// Five mocks base method by wrapping the associated func.
+func (m *EventsIface) Five(ctx context.Context, id string) (int, error) {
+	m.lockFive.Lock()
+	fn := m.FiveFunc
+	if onCall, ok := m.onCalls.Five[len(m.calls.Five)]; ok {
+		fn = onCall
+		delete(m.onCalls.Five, len(m.calls.Five))
+	} else if len(m.queued.Five) > 0 {
+		fn = m.queued.Five[0]
+		m.queued.Five = m.queued.Five[1:]
+	}
+	if fn == nil {
+		if m.t == nil {
+			m.lockFive.Unlock()
+			panic("mocker: EventsIface.FiveFunc is nil but EventsIface.Five was called.")
+		}
+		m.unstubbed.Five++
+	}
+	call := &EventsIfaceFiveCall{
+		Ctx: ctx,
+		Id:  id,
+	}
+	m.calls.Five = append(m.calls.Five, call)
+	m.seqs.Five = append(m.seqs.Five, mocker.Sequence())
+	if m.conds.Five != nil {
+		m.conds.Five.Broadcast()
+	}
+	m.lockFive.Unlock()
+
+	var ret0 int
+	var ret1 error
+	defer func() {
+		r := recover()
+		m.lockFive.Lock()
+		call.Ret0 = ret0
+		call.Ret1 = ret1
+		call.Panic = r
+		m.lockFive.Unlock()
+		m.events.Publish(mocker.CallEvent{
+			Mock:    "EventsIface",
+			Method:  "Five",
+			Args:    []interface{}{ctx, id},
+			Results: []interface{}{ret0, ret1},
+			Panic:   r,
+		})
+		if r != nil {
+			panic(r)
+		}
+	}()
+
+	if fn == nil {
+		return ret0, ret1
+	}
+
+	ret0, ret1 = fn(ctx, id)
+	return ret0, ret1
+}
+
+// FiveCalled returns true if Five was called at least once.
+func (m *EventsIface) FiveCalled() bool {
+	m.lockFive.Lock()
+	defer m.lockFive.Unlock()
+
+	return len(m.calls.Five) > 0
+}
+
+// FiveCalls returns the calls made to Five.
+func (m *EventsIface) FiveCalls() []EventsIfaceFiveCall {
+	m.lockFive.Lock()
+	defer m.lockFive.Unlock()
+
+	var calls []EventsIfaceFiveCall
+	for _, call := range m.calls.Five {
+		calls = append(calls, *call)
+	}
+	return calls
+}
+
+// FiveCallCount returns the number of calls made to Five.
+func (m *EventsIface) FiveCallCount() int {
+	m.lockFive.Lock()
+	defer m.lockFive.Unlock()
+
+	return len(m.calls.Five)
+}
+
+// FiveCallAt returns the i'th call made to Five, counting from 0, failing the
+// test through t if there weren't that many calls.
+func (m *EventsIface) FiveCallAt(t testing.TB, i int) EventsIfaceFiveCall {
+	t.Helper()
+	m.lockFive.Lock()
+	defer m.lockFive.Unlock()
+
+	if i < 0 || i >= len(m.calls.Five) {
+		t.Fatalf("mocker: EventsIface.Five was called %v times, wanted call %v", len(m.calls.Five), i)
+	}
+	return *m.calls.Five[i]
+}
+
+// FiveLastCall returns the last call made to Five, failing the test through t
+// if there weren't any.
+func (m *EventsIface) FiveLastCall(t testing.TB) EventsIfaceFiveCall {
+	t.Helper()
+	m.lockFive.Lock()
+	defer m.lockFive.Unlock()
+
+	if len(m.calls.Five) == 0 {
+		t.Fatalf("mocker: EventsIface.Five wasn't called")
+	}
+	return *m.calls.Five[len(m.calls.Five)-1]
+}
+
+// FiveCallsWhere returns the calls made to Five that fn returns true for.
+func (m *EventsIface) FiveCallsWhere(fn func(EventsIfaceFiveCall) bool) []EventsIfaceFiveCall {
+	var calls []EventsIfaceFiveCall
+	for _, call := range m.FiveCalls() {
+		if fn(call) {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// AssertFiveCalledWith reports through t unless Five was called with args
+// matching the matchers, and returns whether it was.
+func (m *EventsIface) AssertFiveCalledWith(t testing.TB, ctx, id match.Matcher) bool {
+	t.Helper()
+	m.lockFive.Lock()
+	defer m.lockFive.Unlock()
+
+	for _, call := range m.calls.Five {
+		if ctx.Match(call.Ctx) && id.Match(call.Id) {
+			return true
+		}
+	}
+	t.Errorf("mocker: EventsIface.Five wasn't called with (%v, %v)", ctx, id)
+	return false
+}
+
+// FiveCall describes the calls made to Five with args matching args, which
+// may be match.Matchers, for asserting on their order with mocker.InOrder.
+func (m *EventsIface) FiveCall(ctx, id interface{}) mocker.Call {
+	m.lockFive.Lock()
+	defer m.lockFive.Unlock()
+
+	var seqs []uint64
+	for i, call := range m.calls.Five {
+		if match.Of(ctx).Match(call.Ctx) && match.Of(id).Match(call.Id) {
+			seqs = append(seqs, m.seqs.Five[i])
+		}
+	}
+	return mocker.Call{
+		Desc: mocker.Describe("EventsIface.Five", ctx, id),
+		Seqs: seqs,
+	}
+}
+
+// WaitForFive blocks until Five has been called at least n times, returning
+// nil, or until ctx_2 is done, returning its error.
+func (m *EventsIface) WaitForFive(ctx_2 context.Context, n int) error {
+	m.lockFive.Lock()
+	defer m.lockFive.Unlock()
+
+	if m.conds.Five == nil {
+		m.conds.Five = sync.NewCond(&m.lockFive)
+	}
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		select {
+		case <-ctx_2.Done():
+			m.lockFive.Lock()
+			m.conds.Five.Broadcast()
+			m.lockFive.Unlock()
+		case <-stop:
+		}
+	}()
+	for len(m.calls.Five) < n {
+		if err := ctx_2.Err(); err != nil {
+			return err
+		}
+		m.conds.Five.Wait()
+	}
+	return nil
+}
+
+// ResetFive resets the calls made to Five. Stubs set with OnFiveCall for calls
+// yet to be made keep their calls, now counted from the reset.
+func (m *EventsIface) ResetFive() {
+	m.lockFive.Lock()
+	defer m.lockFive.Unlock()
+
+	if n := len(m.calls.Five); n > 0 && m.onCalls.Five != nil {
+		onCalls := make(map[int]EventsIfaceFiveFunc, len(m.onCalls.Five))
+		for i, fn := range m.onCalls.Five {
+			if i >= n {
+				onCalls[i-n] = fn
+			}
+		}
+		m.onCalls.Five = onCalls
+	}
+	m.calls.Five = nil
+	m.seqs.Five = nil
+	m.unstubbed.Five = 0
+}
+
+// OnFiveCall makes the n'th call to Five, counting from 0 like FiveCalls, call fn
+// rather than FiveFunc.
+func (m *EventsIface) OnFiveCall(n int, fn EventsIfaceFiveFunc) {
+	m.lockFive.Lock()
+	defer m.lockFive.Unlock()
+
+	if m.onCalls.Five == nil {
+		m.onCalls.Five = make(map[int]EventsIfaceFiveFunc)
+	}
+	m.onCalls.Five[n] = fn
+}
+
+// FiveReturnsSequence queues fns for the next calls to Five to call in turn, one
+// per call, after any queued before, then falling back to FiveFunc. Calls
+// stubbed with OnFiveCall don't use up the queue.
+func (m *EventsIface) FiveReturnsSequence(fns ...EventsIfaceFiveFunc) {
+	m.lockFive.Lock()
+	defer m.lockFive.Unlock()
+
+	m.queued.Five = append(m.queued.Five, fns...)
+}
+
+// WithFive sets FiveFunc to fn, taking the lock calls to Five take so it's safe
+// once the mock's shared, and returns the mock.
+func (m *EventsIface) WithFive(fn EventsIfaceFiveFunc) *EventsIface {
+	m.lockFive.Lock()
+	m.FiveFunc = fn
+	m.lockFive.Unlock()
+	return m
+}
+
+// Reset resets the calls made to the mocked methods.
+func (m *EventsIface) Reset() {
+	m.ResetOne()
+	m.ResetTwo()
+	m.ResetThree()
+	m.ResetFour()
+	m.ResetFive()
+}
+
+// ResetStubs clears the funcs and per-call stubs of the mocked methods.
+func (m *EventsIface) ResetStubs() {
+	m.lockOne.Lock()
+	m.OneFunc = nil
+	m.onCalls.One = nil
+	m.queued.One = nil
+	m.lockOne.Unlock()
+	m.lockTwo.Lock()
+	m.TwoFunc = nil
+	m.onCalls.Two = nil
+	m.queued.Two = nil
+	m.lockTwo.Unlock()
+	m.lockThree.Lock()
+	m.ThreeFunc = nil
+	m.onCalls.Three = nil
+	m.queued.Three = nil
+	m.lockThree.Unlock()
+	m.lockFour.Lock()
+	m.FourFunc = nil
+	m.onCalls.Four = nil
+	m.queued.Four = nil
+	m.lockFour.Unlock()
+	m.lockFive.Lock()
+	m.FiveFunc = nil
+	m.onCalls.Five = nil
+	m.queued.Five = nil
+	m.lockFive.Unlock()
+}
+
+// ResetAll resets the calls made to the mocked methods and clears their
+// funcs and per-call stubs.
+func (m *EventsIface) ResetAll() {
+	m.Reset()
+	m.ResetStubs()
+}
+
+// Verify reports through t any calls made to methods without a func, funcs
+// and per-call stubs that weren't called, and unmet expectations. It returns
+// whether there were none.
+func (m *EventsIface) Verify(t testing.TB) bool {
+	t.Helper()
+	ok := true
+	m.lockOne.Lock()
+	if m.unstubbed.One > 0 {
+		t.Errorf("mocker: EventsIface.One was called %v times without a func", m.unstubbed.One)
+		ok = false
+	}
+	if m.OneFunc != nil && len(m.calls.One) == 0 {
+		t.Errorf("mocker: EventsIface.OneFunc is set but One wasn't called")
+		ok = false
+	}
+	for n := range m.onCalls.One {
+		t.Errorf("mocker: EventsIface.One's stub for call %v wasn't called", n)
+		ok = false
+	}
+	if len(m.queued.One) > 0 {
+		t.Errorf("mocker: EventsIface.One's last %v stubs in sequence weren't called", len(m.queued.One))
+		ok = false
+	}
+	m.lockOne.Unlock()
+	m.lockTwo.Lock()
+	if m.unstubbed.Two > 0 {
+		t.Errorf("mocker: EventsIface.Two was called %v times without a func", m.unstubbed.Two)
+		ok = false
+	}
+	if m.TwoFunc != nil && len(m.calls.Two) == 0 {
+		t.Errorf("mocker: EventsIface.TwoFunc is set but Two wasn't called")
+		ok = false
+	}
+	for n := range m.onCalls.Two {
+		t.Errorf("mocker: EventsIface.Two's stub for call %v wasn't called", n)
+		ok = false
+	}
+	if len(m.queued.Two) > 0 {
+		t.Errorf("mocker: EventsIface.Two's last %v stubs in sequence weren't called", len(m.queued.Two))
+		ok = false
+	}
+	m.lockTwo.Unlock()
+	m.lockThree.Lock()
+	if m.unstubbed.Three > 0 {
+		t.Errorf("mocker: EventsIface.Three was called %v times without a func", m.unstubbed.Three)
+		ok = false
+	}
+	if m.ThreeFunc != nil && len(m.calls.Three) == 0 {
+		t.Errorf("mocker: EventsIface.ThreeFunc is set but Three wasn't called")
+		ok = false
+	}
+	for n := range m.onCalls.Three {
+		t.Errorf("mocker: EventsIface.Three's stub for call %v wasn't called", n)
+		ok = false
+	}
+	if len(m.queued.Three) > 0 {
+		t.Errorf("mocker: EventsIface.Three's last %v stubs in sequence weren't called", len(m.queued.Three))
+		ok = false
+	}
+	m.lockThree.Unlock()
+	m.lockFour.Lock()
+	if m.unstubbed.Four > 0 {
+		t.Errorf("mocker: EventsIface.Four was called %v times without a func", m.unstubbed.Four)
+		ok = false
+	}
+	if m.FourFunc != nil && len(m.calls.Four) == 0 {
+		t.Errorf("mocker: EventsIface.FourFunc is set but Four wasn't called")
+		ok = false
+	}
+	for n := range m.onCalls.Four {
+		t.Errorf("mocker: EventsIface.Four's stub for call %v wasn't called", n)
+		ok = false
+	}
+	if len(m.queued.Four) > 0 {
+		t.Errorf("mocker: EventsIface.Four's last %v stubs in sequence weren't called", len(m.queued.Four))
+		ok = false
+	}
+	m.lockFour.Unlock()
+	m.lockFive.Lock()
+	if m.unstubbed.Five > 0 {
+		t.Errorf("mocker: EventsIface.Five was called %v times without a func", m.unstubbed.Five)
+		ok = false
+	}
+	if m.FiveFunc != nil && len(m.calls.Five) == 0 {
+		t.Errorf("mocker: EventsIface.FiveFunc is set but Five wasn't called")
+		ok = false
+	}
+	for n := range m.onCalls.Five {
+		t.Errorf("mocker: EventsIface.Five's stub for call %v wasn't called", n)
+		ok = false
+	}
+	if len(m.queued.Five) > 0 {
+		t.Errorf("mocker: EventsIface.Five's last %v stubs in sequence weren't called", len(m.queued.Five))
+		ok = false
+	}
+	m.lockFive.Unlock()
+	return ok
+}
+
+// Subscribe returns a channel receiving the calls made to the mock from now
+// on, once they've returned or panicked, and a func unsubscribing it.
+// Unsubscribing closes the channel, and subscribers are unsubscribed
+// when the test's done.
+func (m *EventsIface) Subscribe() (<-chan mocker.CallEvent, func()) {
+	return m.events.Subscribe()
+}
+
+// EventsClash is a mock of Clash interface
+type EventsClash struct {
+	lockVerify sync.Mutex
+	VerifyFunc EventsClashVerifyFunc
+
+	lockClose sync.Mutex
+	CloseFunc EventsClashCloseFunc
+
+	lockKeys sync.Mutex
+	KeysFunc EventsClashKeysFunc
+
+	lockDo sync.Mutex
+	DoFunc EventsClashDoFunc
+
+	lockDoCall sync.Mutex
+	DoCallFunc EventsClashDoCallFunc
+
+	lockAll sync.Mutex
+	AllFunc EventsClashAllFunc
+
+	lockStubs sync.Mutex
+	StubsFunc EventsClashStubsFunc
+
+	lockFoo sync.Mutex
+	FooFunc EventsClashFooFunc
+
+	lockResetFoo sync.Mutex
+	ResetFooFunc EventsClashResetFooFunc
+
+	lockWait sync.Mutex
+	WaitFunc EventsClashWaitFunc
+
+	lockWaitForWait sync.Mutex
+	WaitForWaitFunc EventsClashWaitForWaitFunc
+
+	lockSubscribe sync.Mutex
+	SubscribeFunc EventsClashSubscribeFunc
+
+	calls struct {
+		Verify      []*EventsClashVerifyCall
+		Close       []*EventsClashCloseCall
+		Keys        []*EventsClashKeysCall
+		Do          []*EventsClashDoCall
+		DoCall      []*EventsClashDoCallCall
+		All         []*EventsClashAllCall
+		Stubs       []*EventsClashStubsCall
+		Foo         []*EventsClashFooCall
+		ResetFoo    []*EventsClashResetFooCall
+		Wait        []*EventsClashWaitCall
+		WaitForWait []*EventsClashWaitForWaitCall
+		Subscribe   []*EventsClashSubscribeCall
+	}
+	seqs struct {
+		Verify      []uint64
+		Close       []uint64
+		Keys        []uint64
+		Do          []uint64
+		DoCall      []uint64
+		All         []uint64
+		Stubs       []uint64
+		Foo         []uint64
+		ResetFoo    []uint64
+		Wait        []uint64
+		WaitForWait []uint64
+		Subscribe   []uint64
+	}
+	conds struct {
+		Verify      *sync.Cond
+		Close       *sync.Cond
+		Keys        *sync.Cond
+		Do          *sync.Cond
+		DoCall      *sync.Cond
+		All         *sync.Cond
+		Stubs       *sync.Cond
+		Foo         *sync.Cond
+		ResetFoo    *sync.Cond
+		Wait        *sync.Cond
+		WaitForWait *sync.Cond
+		Subscribe   *sync.Cond
+	}
+	onCalls struct {
+		Verify      map[int]EventsClashVerifyFunc
+		Close       map[int]EventsClashCloseFunc
+		Keys        map[int]EventsClashKeysFunc
+		Do          map[int]EventsClashDoFunc
+		DoCall      map[int]EventsClashDoCallFunc
+		All         map[int]EventsClashAllFunc
+		Stubs       map[int]EventsClashStubsFunc
+		Foo         map[int]EventsClashFooFunc
+		ResetFoo    map[int]EventsClashResetFooFunc
+		Wait        map[int]EventsClashWaitFunc
+		WaitForWait map[int]EventsClashWaitForWaitFunc
+		Subscribe   map[int]EventsClashSubscribeFunc
+	}
+	queued struct {
+		Verify      []EventsClashVerifyFunc
+		Close       []EventsClashCloseFunc
+		Keys        []EventsClashKeysFunc
+		Do          []EventsClashDoFunc
+		DoCall      []EventsClashDoCallFunc
+		All         []EventsClashAllFunc
+		Stubs       []EventsClashStubsFunc
+		Foo         []EventsClashFooFunc
+		ResetFoo    []EventsClashResetFooFunc
+		Wait        []EventsClashWaitFunc
+		WaitForWait []EventsClashWaitForWaitFunc
+		Subscribe   []EventsClashSubscribeFunc
+	}
+	unstubbed struct {
+		Verify      int
+		Close       int
+		Keys        int
+		Do          int
+		DoCall      int
+		All         int
+		Stubs       int
+		Foo         int
+		ResetFoo    int
+		Wait        int
+		WaitForWait int
+		Subscribe   int
+	}
+	events mocker.Events
+	t      testing.TB
+}
+
+// EventsClash must implement Clash, so the build breaks if it's stale.
+var _ Clash = (*EventsClash)(nil)
+
+// NewEventsClash returns a EventsClash that reports calls to methods without a
+// func through t, rather than panicking, and verifies it was used as
+// stubbed when the test's done.
+func NewEventsClash(t testing.TB) *EventsClash {
+	m := &EventsClash{t: t}
+	t.Cleanup(func() {
+		m.Verify_2(t)
+		m.events.Close()
+	})
+	return m
+}
+
+// EventsClashVerifyFunc is the func EventsClash.Verify calls.
+type EventsClashVerifyFunc func(token string) error
+
+// EventsClashVerifyCall is a call made to EventsClash.Verify.
+type EventsClashVerifyCall struct {
+	Token string
+
+	Ret0 error
+
+	// Panic is the value the call panicked with, if it did.
+	Panic interface{}
+}
+
+// Verify mocks base method by wrapping the associated func.
+func (m *EventsClash) Verify(token string) error {
+	m.lockVerify.Lock()
+	fn := m.VerifyFunc
+	if onCall, ok := m.onCalls.Verify[len(m.calls.Verify)]; ok {
+		fn = onCall
+		delete(m.onCalls.Verify, len(m.calls.Verify))
+	} else if len(m.queued.Verify) > 0 {
+		fn = m.queued.Verify[0]
+		m.queued.Verify = m.queued.Verify[1:]
+	}
+	if fn == nil {
+		if m.t == nil {
+			m.lockVerify.Unlock()
+			panic("mocker: EventsClash.VerifyFunc is nil but EventsClash.Verify was called.")
+		}
+		m.unstubbed.Verify++
+	}
+	call := &EventsClashVerifyCall{
+		Token: token,
+	}
+	m.calls.Verify = append(m.calls.Verify, call)
+	m.seqs.Verify = append(m.seqs.Verify, mocker.Sequence())
+	if m.conds.Verify != nil {
+		m.conds.Verify.Broadcast()
+	}
+	m.lockVerify.Unlock()
+
+	var ret0 error
+	defer func() {
+		r := recover()
+		m.lockVerify.Lock()
+		call.Ret0 = ret0
+		call.Panic = r
+		m.lockVerify.Unlock()
+		m.events.Publish(mocker.CallEvent{
+			Mock:    "EventsClash",
+			Method:  "Verify",
+			Args:    []interface{}{token},
+			Results: []interface{}{ret0},
+			Panic:   r,
+		})
+		if r != nil {
+			panic(r)
+		}
+	}()
+
+	if fn == nil {
+		return ret0
+	}
+
+	ret0 = fn(token)
+	return ret0
+}
+
+// VerifyCalled returns true if Verify was called at least once.
+func (m *EventsClash) VerifyCalled() bool {
+	m.lockVerify.Lock()
+	defer m.lockVerify.Unlock()
+
+	return len(m.calls.Verify) > 0
+}
+
+// VerifyCalls returns the calls made to Verify.
+func (m *EventsClash) VerifyCalls() []EventsClashVerifyCall {
+	m.lockVerify.Lock()
+	defer m.lockVerify.Unlock()
+
+	var calls []EventsClashVerifyCall
+	for _, call := range m.calls.Verify {
+		calls = append(calls, *call)
+	}
+	return calls
+}
+
+// VerifyCallCount returns the number of calls made to Verify.
+func (m *EventsClash) VerifyCallCount() int {
+	m.lockVerify.Lock()
+	defer m.lockVerify.Unlock()
+
+	return len(m.calls.Verify)
+}
+
+// VerifyCallAt returns the i'th call made to Verify, counting from 0, failing the
+// test through t if there weren't that many calls.
+func (m *EventsClash) VerifyCallAt(t testing.TB, i int) EventsClashVerifyCall {
+	t.Helper()
+	m.lockVerify.Lock()
+	defer m.lockVerify.Unlock()
+
+	if i < 0 || i >= len(m.calls.Verify) {
+		t.Fatalf("mocker: EventsClash.Verify was called %v times, wanted call %v", len(m.calls.Verify), i)
+	}
+	return *m.calls.Verify[i]
+}
+
+// VerifyLastCall returns the last call made to Verify, failing the test through t
+// if there weren't any.
+func (m *EventsClash) VerifyLastCall(t testing.TB) EventsClashVerifyCall {
+	t.Helper()
+	m.lockVerify.Lock()
+	defer m.lockVerify.Unlock()
+
+	if len(m.calls.Verify) == 0 {
+		t.Fatalf("mocker: EventsClash.Verify wasn't called")
+	}
+	return *m.calls.Verify[len(m.calls.Verify)-1]
+}
+
+// VerifyCallsWhere returns the calls made to Verify that fn returns true for.
+func (m *EventsClash) VerifyCallsWhere(fn func(EventsClashVerifyCall) bool) []EventsClashVerifyCall {
+	var calls []EventsClashVerifyCall
+	for _, call := range m.VerifyCalls() {
+		if fn(call) {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// AssertVerifyCalledWith reports through t unless Verify was called with args
+// matching the matchers, and returns whether it was.
+func (m *EventsClash) AssertVerifyCalledWith(t testing.TB, token match.Matcher) bool {
+	t.Helper()
+	m.lockVerify.Lock()
+	defer m.lockVerify.Unlock()
+
+	for _, call := range m.calls.Verify {
+		if token.Match(call.Token) {
+			return true
+		}
+	}
+	t.Errorf("mocker: EventsClash.Verify wasn't called with (%v)", token)
+	return false
+}
+
+// VerifyCall describes the calls made to Verify with args matching args, which
+// may be match.Matchers, for asserting on their order with mocker.InOrder.
+func (m *EventsClash) VerifyCall(token interface{}) mocker.Call {
+	m.lockVerify.Lock()
+	defer m.lockVerify.Unlock()
+
+	var seqs []uint64
+	for i, call := range m.calls.Verify {
+		if match.Of(token).Match(call.Token) {
+			seqs = append(seqs, m.seqs.Verify[i])
+		}
+	}
+	return mocker.Call{
+		Desc: mocker.Describe("EventsClash.Verify", token),
+		Seqs: seqs,
+	}
+}
+
+// WaitForVerify blocks until Verify has been called at least n times, returning
+// nil, or until ctx is done, returning its error.
+func (m *EventsClash) WaitForVerify(ctx context.Context, n int) error {
+	m.lockVerify.Lock()
+	defer m.lockVerify.Unlock()
+
+	if m.conds.Verify == nil {
+		m.conds.Verify = sync.NewCond(&m.lockVerify)
+	}
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		select {
+		case <-ctx.Done():
+			m.lockVerify.Lock()
+			m.conds.Verify.Broadcast()
+			m.lockVerify.Unlock()
+		case <-stop:
+		}
+	}()
+	for len(m.calls.Verify) < n {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		m.conds.Verify.Wait()
+	}
+	return nil
+}
+
+// ResetVerify resets the calls made to Verify. Stubs set with OnVerifyCall for calls
+// yet to be made keep their calls, now counted from the reset.
+func (m *EventsClash) ResetVerify() {
+	m.lockVerify.Lock()
+	defer m.lockVerify.Unlock()
+
+	if n := len(m.calls.Verify); n > 0 && m.onCalls.Verify != nil {
+		onCalls := make(map[int]EventsClashVerifyFunc, len(m.onCalls.Verify))
+		for i, fn := range m.onCalls.Verify {
+			if i >= n {
+				onCalls[i-n] = fn
+			}
+		}
+		m.onCalls.Verify = onCalls
+	}
+	m.calls.Verify = nil
+	m.seqs.Verify = nil
+	m.unstubbed.Verify = 0
+}
+
+// OnVerifyCall makes the n'th call to Verify, counting from 0 like VerifyCalls, call fn
+// rather than VerifyFunc.
+func (m *EventsClash) OnVerifyCall(n int, fn EventsClashVerifyFunc) {
+	m.lockVerify.Lock()
+	defer m.lockVerify.Unlock()
+
+	if m.onCalls.Verify == nil {
+		m.onCalls.Verify = make(map[int]EventsClashVerifyFunc)
+	}
+	m.onCalls.Verify[n] = fn
+}
+
+// VerifyReturnsSequence queues fns for the next calls to Verify to call in turn, one
+// per call, after any queued before, then falling back to VerifyFunc. Calls
+// stubbed with OnVerifyCall don't use up the queue.
+func (m *EventsClash) VerifyReturnsSequence(fns ...EventsClashVerifyFunc) {
+	m.lockVerify.Lock()
+	defer m.lockVerify.Unlock()
+
+	m.queued.Verify = append(m.queued.Verify, fns...)
+}
+
+// WithVerify sets VerifyFunc to fn, taking the lock calls to Verify take so it's safe
+// once the mock's shared, and returns the mock.
+func (m *EventsClash) WithVerify(fn EventsClashVerifyFunc) *EventsClash {
+	m.lockVerify.Lock()
+	m.VerifyFunc = fn
+	m.lockVerify.Unlock()
+	return m
+}
+
+// EventsClashCloseFunc is the func EventsClash.Close calls.
+type EventsClashCloseFunc func() error
+
+// EventsClashCloseCall is a call made to EventsClash.Close.
+type EventsClashCloseCall struct {
+	Ret0 error
+
+	// Panic is the value the call panicked with, if it did.
+	Panic interface{}
+}
+
+// Close mocks base method by wrapping the associated func.
+func (m *EventsClash) Close() error {
+	m.lockClose.Lock()
+	fn := m.CloseFunc
+	if onCall, ok := m.onCalls.Close[len(m.calls.Close)]; ok {
+		fn = onCall
+		delete(m.onCalls.Close, len(m.calls.Close))
+	} else if len(m.queued.Close) > 0 {
+		fn = m.queued.Close[0]
+		m.queued.Close = m.queued.Close[1:]
+	}
+	if fn == nil {
+		if m.t == nil {
+			m.lockClose.Unlock()
+			panic("mocker: EventsClash.CloseFunc is nil but EventsClash.Close was called.")
+		}
+		m.unstubbed.Close++
+	}
+	call := &EventsClashCloseCall{}
+	m.calls.Close = append(m.calls.Close, call)
+	m.seqs.Close = append(m.seqs.Close, mocker.Sequence())
+	if m.conds.Close != nil {
+		m.conds.Close.Broadcast()
+	}
+	m.lockClose.Unlock()
+
+	var ret0 error
+	defer func() {
+		r := recover()
+		m.lockClose.Lock()
+		call.Ret0 = ret0
+		call.Panic = r
+		m.lockClose.Unlock()
+		m.events.Publish(mocker.CallEvent{
+			Mock:    "EventsClash",
+			Method:  "Close",
+			Args:    []interface{}{},
+			Results: []interface{}{ret0},
+			Panic:   r,
+		})
+		if r != nil {
+			panic(r)
+		}
+	}()
+
+	if fn == nil {
+		return ret0
+	}
+
+	ret0 = fn()
+	return ret0
+}
+
+// CloseCalled returns true if Close was called at least once.
+func (m *EventsClash) CloseCalled() bool {
+	m.lockClose.Lock()
+	defer m.lockClose.Unlock()
+
+	return len(m.calls.Close) > 0
+}
+
+// CloseCalls returns the calls made to Close.
+func (m *EventsClash) CloseCalls() []EventsClashCloseCall {
+	m.lockClose.Lock()
+	defer m.lockClose.Unlock()
+
+	var calls []EventsClashCloseCall
+	for _, call := range m.calls.Close {
+		calls = append(calls, *call)
+	}
+	return calls
+}
+
+// CloseCallCount returns the number of calls made to Close.
+func (m *EventsClash) CloseCallCount() int {
+	m.lockClose.Lock()
+	defer m.lockClose.Unlock()
+
+	return len(m.calls.Close)
+}
+
+// CloseCallAt returns the i'th call made to Close, counting from 0, failing the
+// test through t if there weren't that many calls.
+func (m *EventsClash) CloseCallAt(t testing.TB, i int) EventsClashCloseCall {
+	t.Helper()
+	m.lockClose.Lock()
+	defer m.lockClose.Unlock()
+
+	if i < 0 || i >= len(m.calls.Close) {
+		t.Fatalf("mocker: EventsClash.Close was called %v times, wanted call %v", len(m.calls.Close), i)
+	}
+	return *m.calls.Close[i]
+}
+
+// CloseLastCall returns the last call made to Close, failing the test through t
+// if there weren't any.
+func (m *EventsClash) CloseLastCall(t testing.TB) EventsClashCloseCall {
+	t.Helper()
+	m.lockClose.Lock()
+	defer m.lockClose.Unlock()
+
+	if len(m.calls.Close) == 0 {
+		t.Fatalf("mocker: EventsClash.Close wasn't called")
+	}
+	return *m.calls.Close[len(m.calls.Close)-1]
+}
+
+// CloseCallsWhere returns the calls made to Close that fn returns true for.
+func (m *EventsClash) CloseCallsWhere(fn func(EventsClashCloseCall) bool) []EventsClashCloseCall {
+	var calls []EventsClashCloseCall
+	for _, call := range m.CloseCalls() {
+		if fn(call) {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// AssertCloseCalledWith reports through t unless Close was called with args
+// matching the matchers, and returns whether it was.
+func (m *EventsClash) AssertCloseCalledWith(t testing.TB) bool {
+	t.Helper()
+	m.lockClose.Lock()
+	defer m.lockClose.Unlock()
+
+	if len(m.calls.Close) > 0 {
+		return true
+	}
+	t.Errorf("mocker: EventsClash.Close wasn't called")
+	return false
+}
+
+// CloseCall describes the calls made to Close with args matching args, which
+// may be match.Matchers, for asserting on their order with mocker.InOrder.
+func (m *EventsClash) CloseCall() mocker.Call {
+	m.lockClose.Lock()
+	defer m.lockClose.Unlock()
+
+	var seqs []uint64
+	seqs = append(seqs, m.seqs.Close...)
+	return mocker.Call{
+		Desc: mocker.Describe("EventsClash.Close"),
+		Seqs: seqs,
+	}
+}
+
+// WaitForClose blocks until Close has been called at least n times, returning
+// nil, or until ctx is done, returning its error.
+func (m *EventsClash) WaitForClose(ctx context.Context, n int) error {
+	m.lockClose.Lock()
+	defer m.lockClose.Unlock()
+
+	if m.conds.Close == nil {
+		m.conds.Close = sync.NewCond(&m.lockClose)
+	}
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		select {
+		case <-ctx.Done():
+			m.lockClose.Lock()
+			m.conds.Close.Broadcast()
+			m.lockClose.Unlock()
+		case <-stop:
+		}
+	}()
+	for len(m.calls.Close) < n {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		m.conds.Close.Wait()
+	}
+	return nil
+}
+
+// ResetClose resets the calls made to Close. Stubs set with OnCloseCall for calls
+// yet to be made keep their calls, now counted from the reset.
+func (m *EventsClash) ResetClose() {
+	m.lockClose.Lock()
+	defer m.lockClose.Unlock()
+
+	if n := len(m.calls.Close); n > 0 && m.onCalls.Close != nil {
+		onCalls := make(map[int]EventsClashCloseFunc, len(m.onCalls.Close))
+		for i, fn := range m.onCalls.Close {
+			if i >= n {
+				onCalls[i-n] = fn
+			}
+		}
+		m.onCalls.Close = onCalls
+	}
+	m.calls.Close = nil
+	m.seqs.Close = nil
+	m.unstubbed.Close = 0
+}
+
+// OnCloseCall makes the n'th call to Close, counting from 0 like CloseCalls, call fn
+// rather than CloseFunc.
+func (m *EventsClash) OnCloseCall(n int, fn EventsClashCloseFunc) {
+	m.lockClose.Lock()
+	defer m.lockClose.Unlock()
+
+	if m.onCalls.Close == nil {
+		m.onCalls.Close = make(map[int]EventsClashCloseFunc)
+	}
+	m.onCalls.Close[n] = fn
+}
+
+// CloseReturnsSequence queues fns for the next calls to Close to call in turn, one
+// per call, after any queued before, then falling back to CloseFunc. Calls
+// stubbed with OnCloseCall don't use up the queue.
+func (m *EventsClash) CloseReturnsSequence(fns ...EventsClashCloseFunc) {
+	m.lockClose.Lock()
+	defer m.lockClose.Unlock()
+
+	m.queued.Close = append(m.queued.Close, fns...)
+}
+
+// WithClose sets CloseFunc to fn, taking the lock calls to Close take so it's safe
+// once the mock's shared, and returns the mock.
+func (m *EventsClash) WithClose(fn EventsClashCloseFunc) *EventsClash {
+	m.lockClose.Lock()
+	m.CloseFunc = fn
+	m.lockClose.Unlock()
+	return m
+}
+
+// EventsClashKeysFunc is the func EventsClash.Keys calls.
+type EventsClashKeysFunc func() []string
+
+// EventsClashKeysCall is a call made to EventsClash.Keys.
+type EventsClashKeysCall struct {
+	Ret0 []string
+
+	// Panic is the value the call panicked with, if it did.
+	Panic interface{}
+}
+
+// Keys mocks base method by wrapping the associated func.
+func (m *EventsClash) Keys() []string {
+	m.lockKeys.Lock()
+	fn := m.KeysFunc
+	if onCall, ok := m.onCalls.Keys[len(m.calls.Keys)]; ok {
+		fn = onCall
+		delete(m.onCalls.Keys, len(m.calls.Keys))
+	} else if len(m.queued.Keys) > 0 {
+		fn = m.queued.Keys[0]
+		m.queued.Keys = m.queued.Keys[1:]
+	}
+	if fn == nil {
+		if m.t == nil {
+			m.lockKeys.Unlock()
+			panic("mocker: EventsClash.KeysFunc is nil but EventsClash.Keys was called.")
+		}
+		m.unstubbed.Keys++
+	}
+	call := &EventsClashKeysCall{}
+	m.calls.Keys = append(m.calls.Keys, call)
+	m.seqs.Keys = append(m.seqs.Keys, mocker.Sequence())
+	if m.conds.Keys != nil {
+		m.conds.Keys.Broadcast()
+	}
+	m.lockKeys.Unlock()
+
+	var ret0 []string
+	defer func() {
+		r := recover()
+		m.lockKeys.Lock()
+		call.Ret0 = ret0
+		call.Panic = r
+		m.lockKeys.Unlock()
+		m.events.Publish(mocker.CallEvent{
+			Mock:    "EventsClash",
+			Method:  "Keys",
+			Args:    []interface{}{},
+			Results: []interface{}{ret0},
+			Panic:   r,
+		})
+		if r != nil {
+			panic(r)
+		}
+	}()
+
+	if fn == nil {
+		return ret0
+	}
+
+	ret0 = fn()
+	return ret0
+}
+
+// KeysCalled returns true if Keys was called at least once.
+func (m *EventsClash) KeysCalled() bool {
+	m.lockKeys.Lock()
+	defer m.lockKeys.Unlock()
+
+	return len(m.calls.Keys) > 0
+}
+
+// KeysCalls returns the calls made to Keys.
+func (m *EventsClash) KeysCalls() []EventsClashKeysCall {
+	m.lockKeys.Lock()
+	defer m.lockKeys.Unlock()
+
+	var calls []EventsClashKeysCall
+	for _, call := range m.calls.Keys {
+		calls = append(calls, *call)
+	}
+	return calls
+}
+
+// KeysCallCount returns the number of calls made to Keys.
+func (m *EventsClash) KeysCallCount() int {
+	m.lockKeys.Lock()
+	defer m.lockKeys.Unlock()
+
+	return len(m.calls.Keys)
+}
+
+// KeysCallAt returns the i'th call made to Keys, counting from 0, failing the
+// test through t if there weren't that many calls.
+func (m *EventsClash) KeysCallAt(t testing.TB, i int) EventsClashKeysCall {
+	t.Helper()
+	m.lockKeys.Lock()
+	defer m.lockKeys.Unlock()
+
+	if i < 0 || i >= len(m.calls.Keys) {
+		t.Fatalf("mocker: EventsClash.Keys was called %v times, wanted call %v", len(m.calls.Keys), i)
+	}
+	return *m.calls.Keys[i]
+}
+
+// KeysLastCall returns the last call made to Keys, failing the test through t
+// if there weren't any.
+func (m *EventsClash) KeysLastCall(t testing.TB) EventsClashKeysCall {
+	t.Helper()
+	m.lockKeys.Lock()
+	defer m.lockKeys.Unlock()
+
+	if len(m.calls.Keys) == 0 {
+		t.Fatalf("mocker: EventsClash.Keys wasn't called")
+	}
+	return *m.calls.Keys[len(m.calls.Keys)-1]
+}
+
+// KeysCallsWhere returns the calls made to Keys that fn returns true for.
+func (m *EventsClash) KeysCallsWhere(fn func(EventsClashKeysCall) bool) []EventsClashKeysCall {
+	var calls []EventsClashKeysCall
+	for _, call := range m.KeysCalls() {
+		if fn(call) {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// AssertKeysCalledWith reports through t unless Keys was called with args
+// matching the matchers, and returns whether it was.
+func (m *EventsClash) AssertKeysCalledWith(t testing.TB) bool {
+	t.Helper()
+	m.lockKeys.Lock()
+	defer m.lockKeys.Unlock()
+
+	if len(m.calls.Keys) > 0 {
+		return true
+	}
+	t.Errorf("mocker: EventsClash.Keys wasn't called")
+	return false
+}
+
+// KeysCall describes the calls made to Keys with args matching args, which
+// may be match.Matchers, for asserting on their order with mocker.InOrder.
+func (m *EventsClash) KeysCall() mocker.Call {
+	m.lockKeys.Lock()
+	defer m.lockKeys.Unlock()
+
+	var seqs []uint64
+	seqs = append(seqs, m.seqs.Keys...)
+	return mocker.Call{
+		Desc: mocker.Describe("EventsClash.Keys"),
+		Seqs: seqs,
+	}
+}
+
+// WaitForKeys blocks until Keys has been called at least n times, returning
+// nil, or until ctx is done, returning its error.
+func (m *EventsClash) WaitForKeys(ctx context.Context, n int) error {
+	m.lockKeys.Lock()
+	defer m.lockKeys.Unlock()
+
+	if m.conds.Keys == nil {
+		m.conds.Keys = sync.NewCond(&m.lockKeys)
+	}
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		select {
+		case <-ctx.Done():
+			m.lockKeys.Lock()
+			m.conds.Keys.Broadcast()
+			m.lockKeys.Unlock()
+		case <-stop:
+		}
+	}()
+	for len(m.calls.Keys) < n {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		m.conds.Keys.Wait()
+	}
+	return nil
+}
+
+// ResetKeys resets the calls made to Keys. Stubs set with OnKeysCall for calls
+// yet to be made keep their calls, now counted from the reset.
+func (m *EventsClash) ResetKeys() {
+	m.lockKeys.Lock()
+	defer m.lockKeys.Unlock()
+
+	if n := len(m.calls.Keys); n > 0 && m.onCalls.Keys != nil {
+		onCalls := make(map[int]EventsClashKeysFunc, len(m.onCalls.Keys))
+		for i, fn := range m.onCalls.Keys {
+			if i >= n {
+				onCalls[i-n] = fn
+			}
+		}
+		m.onCalls.Keys = onCalls
+	}
+	m.calls.Keys = nil
+	m.seqs.Keys = nil
+	m.unstubbed.Keys = 0
+}
+
+// OnKeysCall makes the n'th call to Keys, counting from 0 like KeysCalls, call fn
+// rather than KeysFunc.
+func (m *EventsClash) OnKeysCall(n int, fn EventsClashKeysFunc) {
+	m.lockKeys.Lock()
+	defer m.lockKeys.Unlock()
+
+	if m.onCalls.Keys == nil {
+		m.onCalls.Keys = make(map[int]EventsClashKeysFunc)
+	}
+	m.onCalls.Keys[n] = fn
+}
+
+// KeysReturnsSequence queues fns for the next calls to Keys to call in turn, one
+// per call, after any queued before, then falling back to KeysFunc. Calls
+// stubbed with OnKeysCall don't use up the queue.
+func (m *EventsClash) KeysReturnsSequence(fns ...EventsClashKeysFunc) {
+	m.lockKeys.Lock()
+	defer m.lockKeys.Unlock()
+
+	m.queued.Keys = append(m.queued.Keys, fns...)
+}
+
+// WithKeys sets KeysFunc to fn, taking the lock calls to Keys take so it's safe
+// once the mock's shared, and returns the mock.
+func (m *EventsClash) WithKeys(fn EventsClashKeysFunc) *EventsClash {
+	m.lockKeys.Lock()
+	m.KeysFunc = fn
+	m.lockKeys.Unlock()
+	return m
+}
+
+// EventsClashDoFunc is the func EventsClash.Do calls.
+type EventsClashDoFunc func()
+
+// EventsClashDoCall is a call made to EventsClash.Do.
+type EventsClashDoCall struct {
+	// Panic is the value the call panicked with, if it did.
+	Panic interface{}
+}
+
+// Do mocks base method by wrapping the associated func.
+func (m *EventsClash) Do() {
+	m.lockDo.Lock()
+	fn := m.DoFunc
+	if onCall, ok := m.onCalls.Do[len(m.calls.Do)]; ok {
+		fn = onCall
+		delete(m.onCalls.Do, len(m.calls.Do))
+	} else if len(m.queued.Do) > 0 {
+		fn = m.queued.Do[0]
+		m.queued.Do = m.queued.Do[1:]
+	}
+	if fn == nil {
+		if m.t == nil {
+			m.lockDo.Unlock()
+			panic("mocker: EventsClash.DoFunc is nil but EventsClash.Do was called.")
+		}
+		m.unstubbed.Do++
+	}
+	call := &EventsClashDoCall{}
+	m.calls.Do = append(m.calls.Do, call)
+	m.seqs.Do = append(m.seqs.Do, mocker.Sequence())
+	if m.conds.Do != nil {
+		m.conds.Do.Broadcast()
+	}
+	m.lockDo.Unlock()
+
+	defer func() {
+		r := recover()
+		m.lockDo.Lock()
+		call.Panic = r
+		m.lockDo.Unlock()
+		m.events.Publish(mocker.CallEvent{
+			Mock:    "EventsClash",
+			Method:  "Do",
+			Args:    []interface{}{},
+			Results: []interface{}{},
+			Panic:   r,
+		})
+		if r != nil {
+			panic(r)
+		}
+	}()
+
+	if fn == nil {
+		return
+	}
+
+	fn()
+}
+
+// DoCalled returns true if Do was called at least once.
+func (m *EventsClash) DoCalled() bool {
+	m.lockDo.Lock()
+	defer m.lockDo.Unlock()
+
+	return len(m.calls.Do) > 0
+}
+
+// DoCalls returns the calls made to Do.
+func (m *EventsClash) DoCalls() []EventsClashDoCall {
+	m.lockDo.Lock()
+	defer m.lockDo.Unlock()
+
+	var calls []EventsClashDoCall
+	for _, call := range m.calls.Do {
+		calls = append(calls, *call)
+	}
+	return calls
+}
+
+// DoCallCount returns the number of calls made to Do.
+func (m *EventsClash) DoCallCount() int {
+	m.lockDo.Lock()
+	defer m.lockDo.Unlock()
+
+	return len(m.calls.Do)
+}
+
+// DoCallAt returns the i'th call made to Do, counting from 0, failing the
+// test through t if there weren't that many calls.
+func (m *EventsClash) DoCallAt(t testing.TB, i int) EventsClashDoCall {
+	t.Helper()
+	m.lockDo.Lock()
+	defer m.lockDo.Unlock()
+
+	if i < 0 || i >= len(m.calls.Do) {
+		t.Fatalf("mocker: EventsClash.Do was called %v times, wanted call %v", len(m.calls.Do), i)
+	}
+	return *m.calls.Do[i]
+}
+
+// DoLastCall returns the last call made to Do, failing the test through t
+// if there weren't any.
+func (m *EventsClash) DoLastCall(t testing.TB) EventsClashDoCall {
+	t.Helper()
+	m.lockDo.Lock()
+	defer m.lockDo.Unlock()
+
+	if len(m.calls.Do) == 0 {
+		t.Fatalf("mocker: EventsClash.Do wasn't called")
+	}
+	return *m.calls.Do[len(m.calls.Do)-1]
+}
+
+// DoCallsWhere returns the calls made to Do that fn returns true for.
+func (m *EventsClash) DoCallsWhere(fn func(EventsClashDoCall) bool) []EventsClashDoCall {
+	var calls []EventsClashDoCall
+	for _, call := range m.DoCalls() {
+		if fn(call) {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// AssertDoCalledWith reports through t unless Do was called with args
+// matching the matchers, and returns whether it was.
+func (m *EventsClash) AssertDoCalledWith(t testing.TB) bool {
+	t.Helper()
+	m.lockDo.Lock()
+	defer m.lockDo.Unlock()
+
+	if len(m.calls.Do) > 0 {
+		return true
+	}
+	t.Errorf("mocker: EventsClash.Do wasn't called")
+	return false
+}
+
+// DoCall_2 describes the calls made to Do with args matching args, which
+// may be match.Matchers, for asserting on their order with mocker.InOrder.
+func (m *EventsClash) DoCall_2() mocker.Call {
+	m.lockDo.Lock()
+	defer m.lockDo.Unlock()
+
+	var seqs []uint64
+	seqs = append(seqs, m.seqs.Do...)
+	return mocker.Call{
+		Desc: mocker.Describe("EventsClash.Do"),
+		Seqs: seqs,
+	}
+}
+
+// WaitForDo blocks until Do has been called at least n times, returning
+// nil, or until ctx is done, returning its error.
+func (m *EventsClash) WaitForDo(ctx context.Context, n int) error {
+	m.lockDo.Lock()
+	defer m.lockDo.Unlock()
+
+	if m.conds.Do == nil {
+		m.conds.Do = sync.NewCond(&m.lockDo)
+	}
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		select {
+		case <-ctx.Done():
+			m.lockDo.Lock()
+			m.conds.Do.Broadcast()
+			m.lockDo.Unlock()
+		case <-stop:
+		}
+	}()
+	for len(m.calls.Do) < n {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		m.conds.Do.Wait()
+	}
+	return nil
+}
+
+// ResetDo resets the calls made to Do. Stubs set with OnDoCall for calls
+// yet to be made keep their calls, now counted from the reset.
+func (m *EventsClash) ResetDo() {
+	m.lockDo.Lock()
+	defer m.lockDo.Unlock()
+
+	if n := len(m.calls.Do); n > 0 && m.onCalls.Do != nil {
+		onCalls := make(map[int]EventsClashDoFunc, len(m.onCalls.Do))
+		for i, fn := range m.onCalls.Do {
+			if i >= n {
+				onCalls[i-n] = fn
+			}
+		}
+		m.onCalls.Do = onCalls
+	}
+	m.calls.Do = nil
+	m.seqs.Do = nil
+	m.unstubbed.Do = 0
+}
+
+// OnDoCall makes the n'th call to Do, counting from 0 like DoCalls, call fn
+// rather than DoFunc.
+func (m *EventsClash) OnDoCall(n int, fn EventsClashDoFunc) {
+	m.lockDo.Lock()
+	defer m.lockDo.Unlock()
+
+	if m.onCalls.Do == nil {
+		m.onCalls.Do = make(map[int]EventsClashDoFunc)
+	}
+	m.onCalls.Do[n] = fn
+}
+
+// DoReturnsSequence queues fns for the next calls to Do to call in turn, one
+// per call, after any queued before, then falling back to DoFunc. Calls
+// stubbed with OnDoCall don't use up the queue.
+func (m *EventsClash) DoReturnsSequence(fns ...EventsClashDoFunc) {
+	m.lockDo.Lock()
+	defer m.lockDo.Unlock()
+
+	m.queued.Do = append(m.queued.Do, fns...)
+}
+
+// WithDo sets DoFunc to fn, taking the lock calls to Do take so it's safe
+// once the mock's shared, and returns the mock.
+func (m *EventsClash) WithDo(fn EventsClashDoFunc) *EventsClash {
+	m.lockDo.Lock()
+	m.DoFunc = fn
+	m.lockDo.Unlock()
+	return m
+}
+
+// EventsClashDoCallFunc is the func EventsClash.DoCall calls.
+type EventsClashDoCallFunc func(id string)
+
+// EventsClashDoCallCall is a call made to EventsClash.DoCall.
+type EventsClashDoCallCall struct {
+	Id string
+
+	// Panic is the value the call panicked with, if it did.
+	Panic interface{}
+}
+
+// DoCall mocks base method by wrapping the associated func.
+func (m *EventsClash) DoCall(id string) {
+	m.lockDoCall.Lock()
+	fn := m.DoCallFunc
+	if onCall, ok := m.onCalls.DoCall[len(m.calls.DoCall)]; ok {
+		fn = onCall
+		delete(m.onCalls.DoCall, len(m.calls.DoCall))
+	} else if len(m.queued.DoCall) > 0 {
+		fn = m.queued.DoCall[0]
+		m.queued.DoCall = m.queued.DoCall[1:]
+	}
+	if fn == nil {
+		if m.t == nil {
+			m.lockDoCall.Unlock()
+			panic("mocker: EventsClash.DoCallFunc is nil but EventsClash.DoCall was called.")
+		}
+		m.unstubbed.DoCall++
+	}
+	call := &EventsClashDoCallCall{
+		Id: id,
+	}
+	m.calls.DoCall = append(m.calls.DoCall, call)
+	m.seqs.DoCall = append(m.seqs.DoCall, mocker.Sequence())
+	if m.conds.DoCall != nil {
+		m.conds.DoCall.Broadcast()
+	}
+	m.lockDoCall.Unlock()
+
+	defer func() {
+		r := recover()
+		m.lockDoCall.Lock()
+		call.Panic = r
+		m.lockDoCall.Unlock()
+		m.events.Publish(mocker.CallEvent{
+			Mock:    "EventsClash",
+			Method:  "DoCall",
+			Args:    []interface{}{id},
+			Results: []interface{}{},
+			Panic:   r,
+		})
+		if r != nil {
+			panic(r)
+		}
+	}()
+
+	if fn == nil {
+		return
+	}
+
+	fn(id)
+}
+
+// DoCallCalled returns true if DoCall was called at least once.
+func (m *EventsClash) DoCallCalled() bool {
+	m.lockDoCall.Lock()
+	defer m.lockDoCall.Unlock()
+
+	return len(m.calls.DoCall) > 0
+}
+
+// DoCallCalls returns the calls made to DoCall.
+func (m *EventsClash) DoCallCalls() []EventsClashDoCallCall {
+	m.lockDoCall.Lock()
+	defer m.lockDoCall.Unlock()
+
+	var calls []EventsClashDoCallCall
+	for _, call := range m.calls.DoCall {
+		calls = append(calls, *call)
+	}
+	return calls
+}
+
+// DoCallCallCount returns the number of calls made to DoCall.
+func (m *EventsClash) DoCallCallCount() int {
+	m.lockDoCall.Lock()
+	defer m.lockDoCall.Unlock()
+
+	return len(m.calls.DoCall)
+}
+
+// DoCallCallAt returns the i'th call made to DoCall, counting from 0, failing the
+// test through t if there weren't that many calls.
+func (m *EventsClash) DoCallCallAt(t testing.TB, i int) EventsClashDoCallCall {
+	t.Helper()
+	m.lockDoCall.Lock()
+	defer m.lockDoCall.Unlock()
+
+	if i < 0 || i >= len(m.calls.DoCall) {
+		t.Fatalf("mocker: EventsClash.DoCall was called %v times, wanted call %v", len(m.calls.DoCall), i)
+	}
+	return *m.calls.DoCall[i]
+}
+
+// DoCallLastCall returns the last call made to DoCall, failing the test through t
+// if there weren't any.
+func (m *EventsClash) DoCallLastCall(t testing.TB) EventsClashDoCallCall {
+	t.Helper()
+	m.lockDoCall.Lock()
+	defer m.lockDoCall.Unlock()
+
+	if len(m.calls.DoCall) == 0 {
+		t.Fatalf("mocker: EventsClash.DoCall wasn't called")
+	}
+	return *m.calls.DoCall[len(m.calls.DoCall)-1]
+}
+
+// DoCallCallsWhere returns the calls made to DoCall that fn returns true for.
+func (m *EventsClash) DoCallCallsWhere(fn func(EventsClashDoCallCall) bool) []EventsClashDoCallCall {
+	var calls []EventsClashDoCallCall
+	for _, call := range m.DoCallCalls() {
+		if fn(call) {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// AssertDoCallCalledWith reports through t unless DoCall was called with args
+// matching the matchers, and returns whether it was.
+func (m *EventsClash) AssertDoCallCalledWith(t testing.TB, id match.Matcher) bool {
+	t.Helper()
+	m.lockDoCall.Lock()
+	defer m.lockDoCall.Unlock()
+
+	for _, call := range m.calls.DoCall {
+		if id.Match(call.Id) {
+			return true
+		}
+	}
+	t.Errorf("mocker: EventsClash.DoCall wasn't called with (%v)", id)
+	return false
+}
+
+// DoCallCall describes the calls made to DoCall with args matching args, which
+// may be match.Matchers, for asserting on their order with mocker.InOrder.
+func (m *EventsClash) DoCallCall(id interface{}) mocker.Call {
+	m.lockDoCall.Lock()
+	defer m.lockDoCall.Unlock()
+
+	var seqs []uint64
+	for i, call := range m.calls.DoCall {
+		if match.Of(id).Match(call.Id) {
+			seqs = append(seqs, m.seqs.DoCall[i])
+		}
+	}
+	return mocker.Call{
+		Desc: mocker.Describe("EventsClash.DoCall", id),
+		Seqs: seqs,
+	}
+}
+
+// WaitForDoCall blocks until DoCall has been called at least n times, returning
+// nil, or until ctx is done, returning its error.
+func (m *EventsClash) WaitForDoCall(ctx context.Context, n int) error {
+	m.lockDoCall.Lock()
+	defer m.lockDoCall.Unlock()
+
+	if m.conds.DoCall == nil {
+		m.conds.DoCall = sync.NewCond(&m.lockDoCall)
+	}
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		select {
+		case <-ctx.Done():
+			m.lockDoCall.Lock()
+			m.conds.DoCall.Broadcast()
+			m.lockDoCall.Unlock()
+		case <-stop:
+		}
+	}()
+	for len(m.calls.DoCall) < n {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		m.conds.DoCall.Wait()
+	}
+	return nil
+}
+
+// ResetDoCall resets the calls made to DoCall. Stubs set with OnDoCallCall for calls
+// yet to be made keep their calls, now counted from the reset.
+func (m *EventsClash) ResetDoCall() {
+	m.lockDoCall.Lock()
+	defer m.lockDoCall.Unlock()
+
+	if n := len(m.calls.DoCall); n > 0 && m.onCalls.DoCall != nil {
+		onCalls := make(map[int]EventsClashDoCallFunc, len(m.onCalls.DoCall))
+		for i, fn := range m.onCalls.DoCall {
+			if i >= n {
+				onCalls[i-n] = fn
+			}
+		}
+		m.onCalls.DoCall = onCalls
+	}
+	m.calls.DoCall = nil
+	m.seqs.DoCall = nil
+	m.unstubbed.DoCall = 0
+}
+
+// OnDoCallCall makes the n'th call to DoCall, counting from 0 like DoCallCalls, call fn
+// rather than DoCallFunc.
+func (m *EventsClash) OnDoCallCall(n int, fn EventsClashDoCallFunc) {
+	m.lockDoCall.Lock()
+	defer m.lockDoCall.Unlock()
+
+	if m.onCalls.DoCall == nil {
+		m.onCalls.DoCall = make(map[int]EventsClashDoCallFunc)
+	}
+	m.onCalls.DoCall[n] = fn
+}
+
+// DoCallReturnsSequence queues fns for the next calls to DoCall to call in turn, one
+// per call, after any queued before, then falling back to DoCallFunc. Calls
+// stubbed with OnDoCallCall don't use up the queue.
+func (m *EventsClash) DoCallReturnsSequence(fns ...EventsClashDoCallFunc) {
+	m.lockDoCall.Lock()
+	defer m.lockDoCall.Unlock()
+
+	m.queued.DoCall = append(m.queued.DoCall, fns...)
+}
+
+// WithDoCall sets DoCallFunc to fn, taking the lock calls to DoCall take so it's safe
+// once the mock's shared, and returns the mock.
+func (m *EventsClash) WithDoCall(fn EventsClashDoCallFunc) *EventsClash {
+	m.lockDoCall.Lock()
+	m.DoCallFunc = fn
+	m.lockDoCall.Unlock()
+	return m
+}
+
+// EventsClashAllFunc is the func EventsClash.All calls.
+type EventsClashAllFunc func() []string
+
+// EventsClashAllCall is a call made to EventsClash.All.
+type EventsClashAllCall struct {
+	Ret0 []string
+
+	// Panic is the value the call panicked with, if it did.
+	Panic interface{}
+}
+
+// All mocks base method by wrapping the associated func.
+func (m *EventsClash) All() []string {
+	m.lockAll.Lock()
+	fn := m.AllFunc
+	if onCall, ok := m.onCalls.All[len(m.calls.All)]; ok {
+		fn = onCall
+		delete(m.onCalls.All, len(m.calls.All))
+	} else if len(m.queued.All) > 0 {
+		fn = m.queued.All[0]
+		m.queued.All = m.queued.All[1:]
+	}
+	if fn == nil {
+		if m.t == nil {
+			m.lockAll.Unlock()
+			panic("mocker: EventsClash.AllFunc is nil but EventsClash.All was called.")
+		}
+		m.unstubbed.All++
+	}
+	call := &EventsClashAllCall{}
+	m.calls.All = append(m.calls.All, call)
+	m.seqs.All = append(m.seqs.All, mocker.Sequence())
+	if m.conds.All != nil {
+		m.conds.All.Broadcast()
+	}
+	m.lockAll.Unlock()
+
+	var ret0 []string
+	defer func() {
+		r := recover()
+		m.lockAll.Lock()
+		call.Ret0 = ret0
+		call.Panic = r
+		m.lockAll.Unlock()
+		m.events.Publish(mocker.CallEvent{
+			Mock:    "EventsClash",
+			Method:  "All",
+			Args:    []interface{}{},
+			Results: []interface{}{ret0},
+			Panic:   r,
+		})
+		if r != nil {
+			panic(r)
+		}
+	}()
+
+	if fn == nil {
+		return ret0
+	}
+
+	ret0 = fn()
+	return ret0
+}
+
+// AllCalled returns true if All was called at least once.
+func (m *EventsClash) AllCalled() bool {
+	m.lockAll.Lock()
+	defer m.lockAll.Unlock()
+
+	return len(m.calls.All) > 0
+}
+
+// AllCalls returns the calls made to All.
+func (m *EventsClash) AllCalls() []EventsClashAllCall {
+	m.lockAll.Lock()
+	defer m.lockAll.Unlock()
+
+	var calls []EventsClashAllCall
+	for _, call := range m.calls.All {
+		calls = append(calls, *call)
+	}
+	return calls
+}
+
+// AllCallCount returns the number of calls made to All.
+func (m *EventsClash) AllCallCount() int {
+	m.lockAll.Lock()
+	defer m.lockAll.Unlock()
+
+	return len(m.calls.All)
+}
+
+// AllCallAt returns the i'th call made to All, counting from 0, failing the
+// test through t if there weren't that many calls.
+func (m *EventsClash) AllCallAt(t testing.TB, i int) EventsClashAllCall {
+	t.Helper()
+	m.lockAll.Lock()
+	defer m.lockAll.Unlock()
+
+	if i < 0 || i >= len(m.calls.All) {
+		t.Fatalf("mocker: EventsClash.All was called %v times, wanted call %v", len(m.calls.All), i)
+	}
+	return *m.calls.All[i]
+}
+
+// AllLastCall returns the last call made to All, failing the test through t
+// if there weren't any.
+func (m *EventsClash) AllLastCall(t testing.TB) EventsClashAllCall {
+	t.Helper()
+	m.lockAll.Lock()
+	defer m.lockAll.Unlock()
+
+	if len(m.calls.All) == 0 {
+		t.Fatalf("mocker: EventsClash.All wasn't called")
+	}
+	return *m.calls.All[len(m.calls.All)-1]
+}
+
+// AllCallsWhere returns the calls made to All that fn returns true for.
+func (m *EventsClash) AllCallsWhere(fn func(EventsClashAllCall) bool) []EventsClashAllCall {
+	var calls []EventsClashAllCall
+	for _, call := range m.AllCalls() {
+		if fn(call) {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// AssertAllCalledWith reports through t unless All was called with args
+// matching the matchers, and returns whether it was.
+func (m *EventsClash) AssertAllCalledWith(t testing.TB) bool {
+	t.Helper()
+	m.lockAll.Lock()
+	defer m.lockAll.Unlock()
+
+	if len(m.calls.All) > 0 {
+		return true
+	}
+	t.Errorf("mocker: EventsClash.All wasn't called")
+	return false
+}
+
+// AllCall describes the calls made to All with args matching args, which
+// may be match.Matchers, for asserting on their order with mocker.InOrder.
+func (m *EventsClash) AllCall() mocker.Call {
+	m.lockAll.Lock()
+	defer m.lockAll.Unlock()
+
+	var seqs []uint64
+	seqs = append(seqs, m.seqs.All...)
+	return mocker.Call{
+		Desc: mocker.Describe("EventsClash.All"),
+		Seqs: seqs,
+	}
+}
+
+// WaitForAll blocks until All has been called at least n times, returning
+// nil, or until ctx is done, returning its error.
+func (m *EventsClash) WaitForAll(ctx context.Context, n int) error {
+	m.lockAll.Lock()
+	defer m.lockAll.Unlock()
+
+	if m.conds.All == nil {
+		m.conds.All = sync.NewCond(&m.lockAll)
+	}
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		select {
+		case <-ctx.Done():
+			m.lockAll.Lock()
+			m.conds.All.Broadcast()
+			m.lockAll.Unlock()
+		case <-stop:
+		}
+	}()
+	for len(m.calls.All) < n {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		m.conds.All.Wait()
+	}
+	return nil
+}
+
+// ResetAll_2 resets the calls made to All. Stubs set with OnAllCall for calls
+// yet to be made keep their calls, now counted from the reset.
+func (m *EventsClash) ResetAll_2() {
+	m.lockAll.Lock()
+	defer m.lockAll.Unlock()
+
+	if n := len(m.calls.All); n > 0 && m.onCalls.All != nil {
+		onCalls := make(map[int]EventsClashAllFunc, len(m.onCalls.All))
+		for i, fn := range m.onCalls.All {
+			if i >= n {
+				onCalls[i-n] = fn
+			}
+		}
+		m.onCalls.All = onCalls
+	}
+	m.calls.All = nil
+	m.seqs.All = nil
+	m.unstubbed.All = 0
+}
+
+// OnAllCall makes the n'th call to All, counting from 0 like AllCalls, call fn
+// rather than AllFunc.
+func (m *EventsClash) OnAllCall(n int, fn EventsClashAllFunc) {
+	m.lockAll.Lock()
+	defer m.lockAll.Unlock()
+
+	if m.onCalls.All == nil {
+		m.onCalls.All = make(map[int]EventsClashAllFunc)
+	}
+	m.onCalls.All[n] = fn
+}
+
+// AllReturnsSequence queues fns for the next calls to All to call in turn, one
+// per call, after any queued before, then falling back to AllFunc. Calls
+// stubbed with OnAllCall don't use up the queue.
+func (m *EventsClash) AllReturnsSequence(fns ...EventsClashAllFunc) {
+	m.lockAll.Lock()
+	defer m.lockAll.Unlock()
+
+	m.queued.All = append(m.queued.All, fns...)
+}
+
+// WithAll sets AllFunc to fn, taking the lock calls to All take so it's safe
+// once the mock's shared, and returns the mock.
+func (m *EventsClash) WithAll(fn EventsClashAllFunc) *EventsClash {
+	m.lockAll.Lock()
+	m.AllFunc = fn
+	m.lockAll.Unlock()
+	return m
+}
+
+// EventsClashStubsFunc is the func EventsClash.Stubs calls.
+type EventsClashStubsFunc func()
+
+// EventsClashStubsCall is a call made to EventsClash.Stubs.
+type EventsClashStubsCall struct {
+	// Panic is the value the call panicked with, if it did.
+	Panic interface{}
+}
+
+// Stubs mocks base method by wrapping the associated func.
+func (m *EventsClash) Stubs() {
+	m.lockStubs.Lock()
+	fn := m.StubsFunc
+	if onCall, ok := m.onCalls.Stubs[len(m.calls.Stubs)]; ok {
+		fn = onCall
+		delete(m.onCalls.Stubs, len(m.calls.Stubs))
+	} else if len(m.queued.Stubs) > 0 {
+		fn = m.queued.Stubs[0]
+		m.queued.Stubs = m.queued.Stubs[1:]
+	}
+	if fn == nil {
+		if m.t == nil {
+			m.lockStubs.Unlock()
+			panic("mocker: EventsClash.StubsFunc is nil but EventsClash.Stubs was called.")
+		}
+		m.unstubbed.Stubs++
+	}
+	call := &EventsClashStubsCall{}
+	m.calls.Stubs = append(m.calls.Stubs, call)
+	m.seqs.Stubs = append(m.seqs.Stubs, mocker.Sequence())
+	if m.conds.Stubs != nil {
+		m.conds.Stubs.Broadcast()
+	}
+	m.lockStubs.Unlock()
+
+	defer func() {
+		r := recover()
+		m.lockStubs.Lock()
+		call.Panic = r
+		m.lockStubs.Unlock()
+		m.events.Publish(mocker.CallEvent{
+			Mock:    "EventsClash",
+			Method:  "Stubs",
+			Args:    []interface{}{},
+			Results: []interface{}{},
+			Panic:   r,
+		})
+		if r != nil {
+			panic(r)
+		}
+	}()
+
+	if fn == nil {
+		return
+	}
+
+	fn()
+}
+
+// StubsCalled returns true if Stubs was called at least once.
+func (m *EventsClash) StubsCalled() bool {
+	m.lockStubs.Lock()
+	defer m.lockStubs.Unlock()
+
+	return len(m.calls.Stubs) > 0
+}
+
+// StubsCalls returns the calls made to Stubs.
+func (m *EventsClash) StubsCalls() []EventsClashStubsCall {
+	m.lockStubs.Lock()
+	defer m.lockStubs.Unlock()
+
+	var calls []EventsClashStubsCall
+	for _, call := range m.calls.Stubs {
+		calls = append(calls, *call)
+	}
+	return calls
+}
+
+// StubsCallCount returns the number of calls made to Stubs.
+func (m *EventsClash) StubsCallCount() int {
+	m.lockStubs.Lock()
+	defer m.lockStubs.Unlock()
+
+	return len(m.calls.Stubs)
+}
+
+// StubsCallAt returns the i'th call made to Stubs, counting from 0, failing the
+// test through t if there weren't that many calls.
+func (m *EventsClash) StubsCallAt(t testing.TB, i int) EventsClashStubsCall {
+	t.Helper()
+	m.lockStubs.Lock()
+	defer m.lockStubs.Unlock()
+
+	if i < 0 || i >= len(m.calls.Stubs) {
+		t.Fatalf("mocker: EventsClash.Stubs was called %v times, wanted call %v", len(m.calls.Stubs), i)
+	}
+	return *m.calls.Stubs[i]
+}
+
+// StubsLastCall returns the last call made to Stubs, failing the test through t
+// if there weren't any.
+func (m *EventsClash) StubsLastCall(t testing.TB) EventsClashStubsCall {
+	t.Helper()
+	m.lockStubs.Lock()
+	defer m.lockStubs.Unlock()
+
+	if len(m.calls.Stubs) == 0 {
+		t.Fatalf("mocker: EventsClash.Stubs wasn't called")
+	}
+	return *m.calls.Stubs[len(m.calls.Stubs)-1]
+}
+
+// StubsCallsWhere returns the calls made to Stubs that fn returns true for.
+func (m *EventsClash) StubsCallsWhere(fn func(EventsClashStubsCall) bool) []EventsClashStubsCall {
+	var calls []EventsClashStubsCall
+	for _, call := range m.StubsCalls() {
+		if fn(call) {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// AssertStubsCalledWith reports through t unless Stubs was called with args
+// matching the matchers, and returns whether it was.
+func (m *EventsClash) AssertStubsCalledWith(t testing.TB) bool {
+	t.Helper()
+	m.lockStubs.Lock()
+	defer m.lockStubs.Unlock()
+
+	if len(m.calls.Stubs) > 0 {
+		return true
+	}
+	t.Errorf("mocker: EventsClash.Stubs wasn't called")
+	return false
+}
+
+// StubsCall describes the calls made to Stubs with args matching args, which
+// may be match.Matchers, for asserting on their order with mocker.InOrder.
+func (m *EventsClash) StubsCall() mocker.Call {
+	m.lockStubs.Lock()
+	defer m.lockStubs.Unlock()
+
+	var seqs []uint64
+	seqs = append(seqs, m.seqs.Stubs...)
+	return mocker.Call{
+		Desc: mocker.Describe("EventsClash.Stubs"),
+		Seqs: seqs,
+	}
+}
+
+// WaitForStubs blocks until Stubs has been called at least n times, returning
+// nil, or until ctx is done, returning its error.
+func (m *EventsClash) WaitForStubs(ctx context.Context, n int) error {
+	m.lockStubs.Lock()
+	defer m.lockStubs.Unlock()
+
+	if m.conds.Stubs == nil {
+		m.conds.Stubs = sync.NewCond(&m.lockStubs)
+	}
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		select {
+		case <-ctx.Done():
+			m.lockStubs.Lock()
+			m.conds.Stubs.Broadcast()
+			m.lockStubs.Unlock()
+		case <-stop:
+		}
+	}()
+	for len(m.calls.Stubs) < n {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		m.conds.Stubs.Wait()
+	}
+	return nil
+}
+
+// ResetStubs_2 resets the calls made to Stubs. Stubs set with OnStubsCall for calls
+// yet to be made keep their calls, now counted from the reset.
+func (m *EventsClash) ResetStubs_2() {
+	m.lockStubs.Lock()
+	defer m.lockStubs.Unlock()
+
+	if n := len(m.calls.Stubs); n > 0 && m.onCalls.Stubs != nil {
+		onCalls := make(map[int]EventsClashStubsFunc, len(m.onCalls.Stubs))
+		for i, fn := range m.onCalls.Stubs {
+			if i >= n {
+				onCalls[i-n] = fn
+			}
+		}
+		m.onCalls.Stubs = onCalls
+	}
+	m.calls.Stubs = nil
+	m.seqs.Stubs = nil
+	m.unstubbed.Stubs = 0
+}
+
+// OnStubsCall makes the n'th call to Stubs, counting from 0 like StubsCalls, call fn
+// rather than StubsFunc.
+func (m *EventsClash) OnStubsCall(n int, fn EventsClashStubsFunc) {
+	m.lockStubs.Lock()
+	defer m.lockStubs.Unlock()
+
+	if m.onCalls.Stubs == nil {
+		m.onCalls.Stubs = make(map[int]EventsClashStubsFunc)
+	}
+	m.onCalls.Stubs[n] = fn
+}
+
+// StubsReturnsSequence queues fns for the next calls to Stubs to call in turn, one
+// per call, after any queued before, then falling back to StubsFunc. Calls
+// stubbed with OnStubsCall don't use up the queue.
+func (m *EventsClash) StubsReturnsSequence(fns ...EventsClashStubsFunc) {
+	m.lockStubs.Lock()
+	defer m.lockStubs.Unlock()
+
+	m.queued.Stubs = append(m.queued.Stubs, fns...)
+}
+
+// WithStubs sets StubsFunc to fn, taking the lock calls to Stubs take so it's safe
+// once the mock's shared, and returns the mock.
+func (m *EventsClash) WithStubs(fn EventsClashStubsFunc) *EventsClash {
+	m.lockStubs.Lock()
+	m.StubsFunc = fn
+	m.lockStubs.Unlock()
+	return m
+}
+
+// EventsClashFooFunc is the func EventsClash.Foo calls.
+type EventsClashFooFunc func(n int)
+
+// EventsClashFooCall is a call made to EventsClash.Foo.
+type EventsClashFooCall struct {
+	N int
+
+	// Panic is the value the call panicked with, if it did.
+	Panic interface{}
+}
+
+// Foo mocks base method by wrapping the associated func.
+func (m *EventsClash) Foo(n int) {
+	m.lockFoo.Lock()
+	fn := m.FooFunc
+	if onCall, ok := m.onCalls.Foo[len(m.calls.Foo)]; ok {
+		fn = onCall
+		delete(m.onCalls.Foo, len(m.calls.Foo))
+	} else if len(m.queued.Foo) > 0 {
+		fn = m.queued.Foo[0]
+		m.queued.Foo = m.queued.Foo[1:]
+	}
+	if fn == nil {
+		if m.t == nil {
+			m.lockFoo.Unlock()
+			panic("mocker: EventsClash.FooFunc is nil but EventsClash.Foo was called.")
+		}
+		m.unstubbed.Foo++
+	}
+	call := &EventsClashFooCall{
+		N: n,
+	}
+	m.calls.Foo = append(m.calls.Foo, call)
+	m.seqs.Foo = append(m.seqs.Foo, mocker.Sequence())
+	if m.conds.Foo != nil {
+		m.conds.Foo.Broadcast()
+	}
+	m.lockFoo.Unlock()
+
+	defer func() {
+		r := recover()
+		m.lockFoo.Lock()
+		call.Panic = r
+		m.lockFoo.Unlock()
+		m.events.Publish(mocker.CallEvent{
+			Mock:    "EventsClash",
+			Method:  "Foo",
+			Args:    []interface{}{n},
+			Results: []interface{}{},
+			Panic:   r,
+		})
+		if r != nil {
+			panic(r)
+		}
+	}()
+
+	if fn == nil {
+		return
+	}
+
+	fn(n)
+}
+
+// FooCalled returns true if Foo was called at least once.
+func (m *EventsClash) FooCalled() bool {
+	m.lockFoo.Lock()
+	defer m.lockFoo.Unlock()
+
+	return len(m.calls.Foo) > 0
+}
+
+// FooCalls returns the calls made to Foo.
+func (m *EventsClash) FooCalls() []EventsClashFooCall {
+	m.lockFoo.Lock()
+	defer m.lockFoo.Unlock()
+
+	var calls []EventsClashFooCall
+	for _, call := range m.calls.Foo {
+		calls = append(calls, *call)
+	}
+	return calls
+}
+
+// FooCallCount returns the number of calls made to Foo.
+func (m *EventsClash) FooCallCount() int {
+	m.lockFoo.Lock()
+	defer m.lockFoo.Unlock()
+
+	return len(m.calls.Foo)
+}
+
+// FooCallAt returns the i'th call made to Foo, counting from 0, failing the
+// test through t if there weren't that many calls.
+func (m *EventsClash) FooCallAt(t testing.TB, i int) EventsClashFooCall {
+	t.Helper()
+	m.lockFoo.Lock()
+	defer m.lockFoo.Unlock()
+
+	if i < 0 || i >= len(m.calls.Foo) {
+		t.Fatalf("mocker: EventsClash.Foo was called %v times, wanted call %v", len(m.calls.Foo), i)
+	}
+	return *m.calls.Foo[i]
+}
+
+// FooLastCall returns the last call made to Foo, failing the test through t
+// if there weren't any.
+func (m *EventsClash) FooLastCall(t testing.TB) EventsClashFooCall {
+	t.Helper()
+	m.lockFoo.Lock()
+	defer m.lockFoo.Unlock()
+
+	if len(m.calls.Foo) == 0 {
+		t.Fatalf("mocker: EventsClash.Foo wasn't called")
+	}
+	return *m.calls.Foo[len(m.calls.Foo)-1]
+}
+
+// FooCallsWhere returns the calls made to Foo that fn returns true for.
+func (m *EventsClash) FooCallsWhere(fn func(EventsClashFooCall) bool) []EventsClashFooCall {
+	var calls []EventsClashFooCall
+	for _, call := range m.FooCalls() {
+		if fn(call) {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// AssertFooCalledWith reports through t unless Foo was called with args
+// matching the matchers, and returns whether it was.
+func (m *EventsClash) AssertFooCalledWith(t testing.TB, n match.Matcher) bool {
+	t.Helper()
+	m.lockFoo.Lock()
+	defer m.lockFoo.Unlock()
+
+	for _, call := range m.calls.Foo {
+		if n.Match(call.N) {
+			return true
+		}
+	}
+	t.Errorf("mocker: EventsClash.Foo wasn't called with (%v)", n)
+	return false
+}
+
+// FooCall describes the calls made to Foo with args matching args, which
+// may be match.Matchers, for asserting on their order with mocker.InOrder.
+func (m *EventsClash) FooCall(n interface{}) mocker.Call {
+	m.lockFoo.Lock()
+	defer m.lockFoo.Unlock()
+
+	var seqs []uint64
+	for i, call := range m.calls.Foo {
+		if match.Of(n).Match(call.N) {
+			seqs = append(seqs, m.seqs.Foo[i])
+		}
+	}
+	return mocker.Call{
+		Desc: mocker.Describe("EventsClash.Foo", n),
+		Seqs: seqs,
+	}
+}
+
+// WaitForFoo blocks until Foo has been called at least n_2 times, returning
+// nil, or until ctx is done, returning its error.
+func (m *EventsClash) WaitForFoo(ctx context.Context, n_2 int) error {
+	m.lockFoo.Lock()
+	defer m.lockFoo.Unlock()
+
+	if m.conds.Foo == nil {
+		m.conds.Foo = sync.NewCond(&m.lockFoo)
+	}
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		select {
+		case <-ctx.Done():
+			m.lockFoo.Lock()
+			m.conds.Foo.Broadcast()
+			m.lockFoo.Unlock()
+		case <-stop:
+		}
+	}()
+	for len(m.calls.Foo) < n_2 {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		m.conds.Foo.Wait()
+	}
+	return nil
+}
+
+// ResetFoo_2 resets the calls made to Foo. Stubs set with OnFooCall for calls
+// yet to be made keep their calls, now counted from the reset.
+func (m *EventsClash) ResetFoo_2() {
+	m.lockFoo.Lock()
+	defer m.lockFoo.Unlock()
+
+	if n_2 := len(m.calls.Foo); n_2 > 0 && m.onCalls.Foo != nil {
+		onCalls := make(map[int]EventsClashFooFunc, len(m.onCalls.Foo))
+		for i, fn := range m.onCalls.Foo {
+			if i >= n_2 {
+				onCalls[i-n_2] = fn
+			}
+		}
+		m.onCalls.Foo = onCalls
+	}
+	m.calls.Foo = nil
+	m.seqs.Foo = nil
+	m.unstubbed.Foo = 0
+}
+
+// OnFooCall makes the n'th call to Foo, counting from 0 like FooCalls, call fn
+// rather than FooFunc.
+func (m *EventsClash) OnFooCall(n int, fn EventsClashFooFunc) {
+	m.lockFoo.Lock()
+	defer m.lockFoo.Unlock()
+
+	if m.onCalls.Foo == nil {
+		m.onCalls.Foo = make(map[int]EventsClashFooFunc)
+	}
+	m.onCalls.Foo[n] = fn
+}
+
+// FooReturnsSequence queues fns for the next calls to Foo to call in turn, one
+// per call, after any queued before, then falling back to FooFunc. Calls
+// stubbed with OnFooCall don't use up the queue.
+func (m *EventsClash) FooReturnsSequence(fns ...EventsClashFooFunc) {
+	m.lockFoo.Lock()
+	defer m.lockFoo.Unlock()
+
+	m.queued.Foo = append(m.queued.Foo, fns...)
+}
+
+// WithFoo sets FooFunc to fn, taking the lock calls to Foo take so it's safe
+// once the mock's shared, and returns the mock.
+func (m *EventsClash) WithFoo(fn EventsClashFooFunc) *EventsClash {
+	m.lockFoo.Lock()
+	m.FooFunc = fn
+	m.lockFoo.Unlock()
+	return m
+}
+
+// EventsClashResetFooFunc is the func EventsClash.ResetFoo calls.
+type EventsClashResetFooFunc func()
+
+// EventsClashResetFooCall is a call made to EventsClash.ResetFoo.
+type EventsClashResetFooCall struct {
+	// Panic is the value the call panicked with, if it did.
+	Panic interface{}
+}
+
+// ResetFoo mocks base method by wrapping the associated func.
+func (m *EventsClash) ResetFoo() {
+	m.lockResetFoo.Lock()
+	fn := m.ResetFooFunc
+	if onCall, ok := m.onCalls.ResetFoo[len(m.calls.ResetFoo)]; ok {
+		fn = onCall
+		delete(m.onCalls.ResetFoo, len(m.calls.ResetFoo))
+	} else if len(m.queued.ResetFoo) > 0 {
+		fn = m.queued.ResetFoo[0]
+		m.queued.ResetFoo = m.queued.ResetFoo[1:]
+	}
+	if fn == nil {
+		if m.t == nil {
+			m.lockResetFoo.Unlock()
+			panic("mocker: EventsClash.ResetFooFunc is nil but EventsClash.ResetFoo was called.")
+		}
+		m.unstubbed.ResetFoo++
+	}
+	call := &EventsClashResetFooCall{}
+	m.calls.ResetFoo = append(m.calls.ResetFoo, call)
+	m.seqs.ResetFoo = append(m.seqs.ResetFoo, mocker.Sequence())
+	if m.conds.ResetFoo != nil {
+		m.conds.ResetFoo.Broadcast()
+	}
+	m.lockResetFoo.Unlock()
+
+	defer func() {
+		r := recover()
+		m.lockResetFoo.Lock()
+		call.Panic = r
+		m.lockResetFoo.Unlock()
+		m.events.Publish(mocker.CallEvent{
+			Mock:    "EventsClash",
+			Method:  "ResetFoo",
+			Args:    []interface{}{},
+			Results: []interface{}{},
+			Panic:   r,
+		})
+		if r != nil {
+			panic(r)
+		}
+	}()
+
+	if fn == nil {
+		return
+	}
+
+	fn()
+}
+
+// ResetFooCalled returns true if ResetFoo was called at least once.
+func (m *EventsClash) ResetFooCalled() bool {
+	m.lockResetFoo.Lock()
+	defer m.lockResetFoo.Unlock()
+
+	return len(m.calls.ResetFoo) > 0
+}
+
+// ResetFooCalls returns the calls made to ResetFoo.
+func (m *EventsClash) ResetFooCalls() []EventsClashResetFooCall {
+	m.lockResetFoo.Lock()
+	defer m.lockResetFoo.Unlock()
+
+	var calls []EventsClashResetFooCall
+	for _, call := range m.calls.ResetFoo {
+		calls = append(calls, *call)
+	}
+	return calls
+}
+
+// ResetFooCallCount returns the number of calls made to ResetFoo.
+func (m *EventsClash) ResetFooCallCount() int {
+	m.lockResetFoo.Lock()
+	defer m.lockResetFoo.Unlock()
+
+	return len(m.calls.ResetFoo)
+}
+
+// ResetFooCallAt returns the i'th call made to ResetFoo, counting from 0, failing the
+// test through t if there weren't that many calls.
+func (m *EventsClash) ResetFooCallAt(t testing.TB, i int) EventsClashResetFooCall {
+	t.Helper()
+	m.lockResetFoo.Lock()
+	defer m.lockResetFoo.Unlock()
+
+	if i < 0 || i >= len(m.calls.ResetFoo) {
+		t.Fatalf("mocker: EventsClash.ResetFoo was called %v times, wanted call %v", len(m.calls.ResetFoo), i)
+	}
+	return *m.calls.ResetFoo[i]
+}
+
+// ResetFooLastCall returns the last call made to ResetFoo, failing the test through t
+// if there weren't any.
+func (m *EventsClash) ResetFooLastCall(t testing.TB) EventsClashResetFooCall {
+	t.Helper()
+	m.lockResetFoo.Lock()
+	defer m.lockResetFoo.Unlock()
+
+	if len(m.calls.ResetFoo) == 0 {
+		t.Fatalf("mocker: EventsClash.ResetFoo wasn't called")
+	}
+	return *m.calls.ResetFoo[len(m.calls.ResetFoo)-1]
+}
+
+// ResetFooCallsWhere returns the calls made to ResetFoo that fn returns true for.
+func (m *EventsClash) ResetFooCallsWhere(fn func(EventsClashResetFooCall) bool) []EventsClashResetFooCall {
+	var calls []EventsClashResetFooCall
+	for _, call := range m.ResetFooCalls() {
+		if fn(call) {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// AssertResetFooCalledWith reports through t unless ResetFoo was called with args
+// matching the matchers, and returns whether it was.
+func (m *EventsClash) AssertResetFooCalledWith(t testing.TB) bool {
+	t.Helper()
+	m.lockResetFoo.Lock()
+	defer m.lockResetFoo.Unlock()
+
+	if len(m.calls.ResetFoo) > 0 {
+		return true
+	}
+	t.Errorf("mocker: EventsClash.ResetFoo wasn't called")
+	return false
+}
+
+// ResetFooCall describes the calls made to ResetFoo with args matching args, which
+// may be match.Matchers, for asserting on their order with mocker.InOrder.
+func (m *EventsClash) ResetFooCall() mocker.Call {
+	m.lockResetFoo.Lock()
+	defer m.lockResetFoo.Unlock()
+
+	var seqs []uint64
+	seqs = append(seqs, m.seqs.ResetFoo...)
+	return mocker.Call{
+		Desc: mocker.Describe("EventsClash.ResetFoo"),
+		Seqs: seqs,
+	}
+}
+
+// WaitForResetFoo blocks until ResetFoo has been called at least n times, returning
+// nil, or until ctx is done, returning its error.
+func (m *EventsClash) WaitForResetFoo(ctx context.Context, n int) error {
+	m.lockResetFoo.Lock()
+	defer m.lockResetFoo.Unlock()
+
+	if m.conds.ResetFoo == nil {
+		m.conds.ResetFoo = sync.NewCond(&m.lockResetFoo)
+	}
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		select {
+		case <-ctx.Done():
+			m.lockResetFoo.Lock()
+			m.conds.ResetFoo.Broadcast()
+			m.lockResetFoo.Unlock()
+		case <-stop:
+		}
+	}()
+	for len(m.calls.ResetFoo) < n {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		m.conds.ResetFoo.Wait()
+	}
+	return nil
+}
+
+// ResetResetFoo resets the calls made to ResetFoo. Stubs set with OnResetFooCall for calls
+// yet to be made keep their calls, now counted from the reset.
+func (m *EventsClash) ResetResetFoo() {
+	m.lockResetFoo.Lock()
+	defer m.lockResetFoo.Unlock()
+
+	if n := len(m.calls.ResetFoo); n > 0 && m.onCalls.ResetFoo != nil {
+		onCalls := make(map[int]EventsClashResetFooFunc, len(m.onCalls.ResetFoo))
+		for i, fn := range m.onCalls.ResetFoo {
+			if i >= n {
+				onCalls[i-n] = fn
+			}
+		}
+		m.onCalls.ResetFoo = onCalls
+	}
+	m.calls.ResetFoo = nil
+	m.seqs.ResetFoo = nil
+	m.unstubbed.ResetFoo = 0
+}
+
+// OnResetFooCall makes the n'th call to ResetFoo, counting from 0 like ResetFooCalls, call fn
+// rather than ResetFooFunc.
+func (m *EventsClash) OnResetFooCall(n int, fn EventsClashResetFooFunc) {
+	m.lockResetFoo.Lock()
+	defer m.lockResetFoo.Unlock()
+
+	if m.onCalls.ResetFoo == nil {
+		m.onCalls.ResetFoo = make(map[int]EventsClashResetFooFunc)
+	}
+	m.onCalls.ResetFoo[n] = fn
+}
+
+// ResetFooReturnsSequence queues fns for the next calls to ResetFoo to call in turn, one
+// per call, after any queued before, then falling back to ResetFooFunc. Calls
+// stubbed with OnResetFooCall don't use up the queue.
+func (m *EventsClash) ResetFooReturnsSequence(fns ...EventsClashResetFooFunc) {
+	m.lockResetFoo.Lock()
+	defer m.lockResetFoo.Unlock()
+
+	m.queued.ResetFoo = append(m.queued.ResetFoo, fns...)
+}
+
+// WithResetFoo sets ResetFooFunc to fn, taking the lock calls to ResetFoo take so it's safe
+// once the mock's shared, and returns the mock.
+func (m *EventsClash) WithResetFoo(fn EventsClashResetFooFunc) *EventsClash {
+	m.lockResetFoo.Lock()
+	m.ResetFooFunc = fn
+	m.lockResetFoo.Unlock()
+	return m
+}
+
+// EventsClashWaitFunc is the func EventsClash.Wait calls.
+type EventsClashWaitFunc func(ctx context.Context, n int)
+
+// EventsClashWaitCall is a call made to EventsClash.Wait.
+type EventsClashWaitCall struct {
+	Ctx context.Context
+	N   int
+
+	// Panic is the value the call panicked with, if it did.
+	Panic interface{}
+}
+
+// Wait mocks base method by wrapping the associated func.
+func (m *EventsClash) Wait(ctx context.Context, n int) {
+	m.lockWait.Lock()
+	fn := m.WaitFunc
+	if onCall, ok := m.onCalls.Wait[len(m.calls.Wait)]; ok {
+		fn = onCall
+		delete(m.onCalls.Wait, len(m.calls.Wait))
+	} else if len(m.queued.Wait) > 0 {
+		fn = m.queued.Wait[0]
+		m.queued.Wait = m.queued.Wait[1:]
+	}
+	if fn == nil {
+		if m.t == nil {
+			m.lockWait.Unlock()
+			panic("mocker: EventsClash.WaitFunc is nil but EventsClash.Wait was called.")
+		}
+		m.unstubbed.Wait++
+	}
+	call := &EventsClashWaitCall{
+		Ctx: ctx,
+		N:   n,
+	}
+	m.calls.Wait = append(m.calls.Wait, call)
+	m.seqs.Wait = append(m.seqs.Wait, mocker.Sequence())
+	if m.conds.Wait != nil {
+		m.conds.Wait.Broadcast()
+	}
+	m.lockWait.Unlock()
+
+	defer func() {
+		r := recover()
+		m.lockWait.Lock()
+		call.Panic = r
+		m.lockWait.Unlock()
+		m.events.Publish(mocker.CallEvent{
+			Mock:    "EventsClash",
+			Method:  "Wait",
+			Args:    []interface{}{ctx, n},
+			Results: []interface{}{},
+			Panic:   r,
+		})
+		if r != nil {
+			panic(r)
+		}
+	}()
+
+	if fn == nil {
+		return
+	}
+
+	fn(ctx, n)
+}
+
+// WaitCalled returns true if Wait was called at least once.
+func (m *EventsClash) WaitCalled() bool {
+	m.lockWait.Lock()
+	defer m.lockWait.Unlock()
+
+	return len(m.calls.Wait) > 0
+}
+
+// WaitCalls returns the calls made to Wait.
+func (m *EventsClash) WaitCalls() []EventsClashWaitCall {
+	m.lockWait.Lock()
+	defer m.lockWait.Unlock()
+
+	var calls []EventsClashWaitCall
+	for _, call := range m.calls.Wait {
+		calls = append(calls, *call)
+	}
+	return calls
+}
+
+// WaitCallCount returns the number of calls made to Wait.
+func (m *EventsClash) WaitCallCount() int {
+	m.lockWait.Lock()
+	defer m.lockWait.Unlock()
+
+	return len(m.calls.Wait)
+}
+
+// WaitCallAt returns the i'th call made to Wait, counting from 0, failing the
+// test through t if there weren't that many calls.
+func (m *EventsClash) WaitCallAt(t testing.TB, i int) EventsClashWaitCall {
+	t.Helper()
+	m.lockWait.Lock()
+	defer m.lockWait.Unlock()
+
+	if i < 0 || i >= len(m.calls.Wait) {
+		t.Fatalf("mocker: EventsClash.Wait was called %v times, wanted call %v", len(m.calls.Wait), i)
+	}
+	return *m.calls.Wait[i]
+}
+
+// WaitLastCall returns the last call made to Wait, failing the test through t
+// if there weren't any.
+func (m *EventsClash) WaitLastCall(t testing.TB) EventsClashWaitCall {
+	t.Helper()
+	m.lockWait.Lock()
+	defer m.lockWait.Unlock()
+
+	if len(m.calls.Wait) == 0 {
+		t.Fatalf("mocker: EventsClash.Wait wasn't called")
+	}
+	return *m.calls.Wait[len(m.calls.Wait)-1]
+}
+
+// WaitCallsWhere returns the calls made to Wait that fn returns true for.
+func (m *EventsClash) WaitCallsWhere(fn func(EventsClashWaitCall) bool) []EventsClashWaitCall {
+	var calls []EventsClashWaitCall
+	for _, call := range m.WaitCalls() {
+		if fn(call) {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// AssertWaitCalledWith reports through t unless Wait was called with args
+// matching the matchers, and returns whether it was.
+func (m *EventsClash) AssertWaitCalledWith(t testing.TB, ctx, n match.Matcher) bool {
+	t.Helper()
+	m.lockWait.Lock()
+	defer m.lockWait.Unlock()
+
+	for _, call := range m.calls.Wait {
+		if ctx.Match(call.Ctx) && n.Match(call.N) {
+			return true
+		}
+	}
+	t.Errorf("mocker: EventsClash.Wait wasn't called with (%v, %v)", ctx, n)
+	return false
+}
+
+// WaitCall describes the calls made to Wait with args matching args, which
+// may be match.Matchers, for asserting on their order with mocker.InOrder.
+func (m *EventsClash) WaitCall(ctx, n interface{}) mocker.Call {
+	m.lockWait.Lock()
+	defer m.lockWait.Unlock()
+
+	var seqs []uint64
+	for i, call := range m.calls.Wait {
+		if match.Of(ctx).Match(call.Ctx) && match.Of(n).Match(call.N) {
+			seqs = append(seqs, m.seqs.Wait[i])
+		}
+	}
+	return mocker.Call{
+		Desc: mocker.Describe("EventsClash.Wait", ctx, n),
+		Seqs: seqs,
+	}
+}
+
+// WaitForWait_2 blocks until Wait has been called at least n_2 times, returning
+// nil, or until ctx_2 is done, returning its error.
+func (m *EventsClash) WaitForWait_2(ctx_2 context.Context, n_2 int) error {
+	m.lockWait.Lock()
+	defer m.lockWait.Unlock()
+
+	if m.conds.Wait == nil {
+		m.conds.Wait = sync.NewCond(&m.lockWait)
+	}
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		select {
+		case <-ctx_2.Done():
+			m.lockWait.Lock()
+			m.conds.Wait.Broadcast()
+			m.lockWait.Unlock()
+		case <-stop:
+		}
+	}()
+	for len(m.calls.Wait) < n_2 {
+		if err := ctx_2.Err(); err != nil {
+			return err
+		}
+		m.conds.Wait.Wait()
+	}
+	return nil
+}
+
+// ResetWait resets the calls made to Wait. Stubs set with OnWaitCall for calls
+// yet to be made keep their calls, now counted from the reset.
+func (m *EventsClash) ResetWait() {
+	m.lockWait.Lock()
+	defer m.lockWait.Unlock()
+
+	if n_2 := len(m.calls.Wait); n_2 > 0 && m.onCalls.Wait != nil {
+		onCalls := make(map[int]EventsClashWaitFunc, len(m.onCalls.Wait))
+		for i, fn := range m.onCalls.Wait {
+			if i >= n_2 {
+				onCalls[i-n_2] = fn
+			}
+		}
+		m.onCalls.Wait = onCalls
+	}
+	m.calls.Wait = nil
+	m.seqs.Wait = nil
+	m.unstubbed.Wait = 0
+}
+
+// OnWaitCall makes the n'th call to Wait, counting from 0 like WaitCalls, call fn
+// rather than WaitFunc.
+func (m *EventsClash) OnWaitCall(n int, fn EventsClashWaitFunc) {
+	m.lockWait.Lock()
+	defer m.lockWait.Unlock()
+
+	if m.onCalls.Wait == nil {
+		m.onCalls.Wait = make(map[int]EventsClashWaitFunc)
+	}
+	m.onCalls.Wait[n] = fn
+}
+
+// WaitReturnsSequence queues fns for the next calls to Wait to call in turn, one
+// per call, after any queued before, then falling back to WaitFunc. Calls
+// stubbed with OnWaitCall don't use up the queue.
+func (m *EventsClash) WaitReturnsSequence(fns ...EventsClashWaitFunc) {
+	m.lockWait.Lock()
+	defer m.lockWait.Unlock()
+
+	m.queued.Wait = append(m.queued.Wait, fns...)
+}
+
+// WithWait sets WaitFunc to fn, taking the lock calls to Wait take so it's safe
+// once the mock's shared, and returns the mock.
+func (m *EventsClash) WithWait(fn EventsClashWaitFunc) *EventsClash {
+	m.lockWait.Lock()
+	m.WaitFunc = fn
+	m.lockWait.Unlock()
+	return m
+}
+
+// EventsClashWaitForWaitFunc is the func EventsClash.WaitForWait calls.
+type EventsClashWaitForWaitFunc func()
+
+// EventsClashWaitForWaitCall is a call made to EventsClash.WaitForWait.
+type EventsClashWaitForWaitCall struct {
+	// Panic is the value the call panicked with, if it did.
+	Panic interface{}
+}
+
+// WaitForWait mocks base method by wrapping the associated func.
+func (m *EventsClash) WaitForWait() {
+	m.lockWaitForWait.Lock()
+	fn := m.WaitForWaitFunc
+	if onCall, ok := m.onCalls.WaitForWait[len(m.calls.WaitForWait)]; ok {
+		fn = onCall
+		delete(m.onCalls.WaitForWait, len(m.calls.WaitForWait))
+	} else if len(m.queued.WaitForWait) > 0 {
+		fn = m.queued.WaitForWait[0]
+		m.queued.WaitForWait = m.queued.WaitForWait[1:]
+	}
+	if fn == nil {
+		if m.t == nil {
+			m.lockWaitForWait.Unlock()
+			panic("mocker: EventsClash.WaitForWaitFunc is nil but EventsClash.WaitForWait was called.")
+		}
+		m.unstubbed.WaitForWait++
+	}
+	call := &EventsClashWaitForWaitCall{}
+	m.calls.WaitForWait = append(m.calls.WaitForWait, call)
+	m.seqs.WaitForWait = append(m.seqs.WaitForWait, mocker.Sequence())
+	if m.conds.WaitForWait != nil {
+		m.conds.WaitForWait.Broadcast()
+	}
+	m.lockWaitForWait.Unlock()
+
+	defer func() {
+		r := recover()
+		m.lockWaitForWait.Lock()
+		call.Panic = r
+		m.lockWaitForWait.Unlock()
+		m.events.Publish(mocker.CallEvent{
+			Mock:    "EventsClash",
+			Method:  "WaitForWait",
+			Args:    []interface{}{},
+			Results: []interface{}{},
+			Panic:   r,
+		})
+		if r != nil {
+			panic(r)
+		}
+	}()
+
+	if fn == nil {
+		return
+	}
+
+	fn()
+}
+
+// WaitForWaitCalled returns true if WaitForWait was called at least once.
+func (m *EventsClash) WaitForWaitCalled() bool {
+	m.lockWaitForWait.Lock()
+	defer m.lockWaitForWait.Unlock()
+
+	return len(m.calls.WaitForWait) > 0
+}
+
+// WaitForWaitCalls returns the calls made to WaitForWait.
+func (m *EventsClash) WaitForWaitCalls() []EventsClashWaitForWaitCall {
+	m.lockWaitForWait.Lock()
+	defer m.lockWaitForWait.Unlock()
+
+	var calls []EventsClashWaitForWaitCall
+	for _, call := range m.calls.WaitForWait {
+		calls = append(calls, *call)
+	}
+	return calls
+}
+
+// WaitForWaitCallCount returns the number of calls made to WaitForWait.
+func (m *EventsClash) WaitForWaitCallCount() int {
+	m.lockWaitForWait.Lock()
+	defer m.lockWaitForWait.Unlock()
+
+	return len(m.calls.WaitForWait)
+}
+
+// WaitForWaitCallAt returns the i'th call made to WaitForWait, counting from 0, failing the
+// test through t if there weren't that many calls.
+func (m *EventsClash) WaitForWaitCallAt(t testing.TB, i int) EventsClashWaitForWaitCall {
+	t.Helper()
+	m.lockWaitForWait.Lock()
+	defer m.lockWaitForWait.Unlock()
+
+	if i < 0 || i >= len(m.calls.WaitForWait) {
+		t.Fatalf("mocker: EventsClash.WaitForWait was called %v times, wanted call %v", len(m.calls.WaitForWait), i)
+	}
+	return *m.calls.WaitForWait[i]
+}
+
+// WaitForWaitLastCall returns the last call made to WaitForWait, failing the test through t
+// if there weren't any.
+func (m *EventsClash) WaitForWaitLastCall(t testing.TB) EventsClashWaitForWaitCall {
+	t.Helper()
+	m.lockWaitForWait.Lock()
+	defer m.lockWaitForWait.Unlock()
+
+	if len(m.calls.WaitForWait) == 0 {
+		t.Fatalf("mocker: EventsClash.WaitForWait wasn't called")
+	}
+	return *m.calls.WaitForWait[len(m.calls.WaitForWait)-1]
+}
+
+// WaitForWaitCallsWhere returns the calls made to WaitForWait that fn returns true for.
+func (m *EventsClash) WaitForWaitCallsWhere(fn func(EventsClashWaitForWaitCall) bool) []EventsClashWaitForWaitCall {
+	var calls []EventsClashWaitForWaitCall
+	for _, call := range m.WaitForWaitCalls() {
+		if fn(call) {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// AssertWaitForWaitCalledWith reports through t unless WaitForWait was called with args
+// matching the matchers, and returns whether it was.
+func (m *EventsClash) AssertWaitForWaitCalledWith(t testing.TB) bool {
+	t.Helper()
+	m.lockWaitForWait.Lock()
+	defer m.lockWaitForWait.Unlock()
+
+	if len(m.calls.WaitForWait) > 0 {
+		return true
+	}
+	t.Errorf("mocker: EventsClash.WaitForWait wasn't called")
+	return false
+}
+
+// WaitForWaitCall describes the calls made to WaitForWait with args matching args, which
+// may be match.Matchers, for asserting on their order with mocker.InOrder.
+func (m *EventsClash) WaitForWaitCall() mocker.Call {
+	m.lockWaitForWait.Lock()
+	defer m.lockWaitForWait.Unlock()
+
+	var seqs []uint64
+	seqs = append(seqs, m.seqs.WaitForWait...)
+	return mocker.Call{
+		Desc: mocker.Describe("EventsClash.WaitForWait"),
+		Seqs: seqs,
+	}
+}
+
+// WaitForWaitForWait blocks until WaitForWait has been called at least n times, returning
+// nil, or until ctx is done, returning its error.
+func (m *EventsClash) WaitForWaitForWait(ctx context.Context, n int) error {
+	m.lockWaitForWait.Lock()
+	defer m.lockWaitForWait.Unlock()
+
+	if m.conds.WaitForWait == nil {
+		m.conds.WaitForWait = sync.NewCond(&m.lockWaitForWait)
+	}
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		select {
+		case <-ctx.Done():
+			m.lockWaitForWait.Lock()
+			m.conds.WaitForWait.Broadcast()
+			m.lockWaitForWait.Unlock()
+		case <-stop:
+		}
+	}()
+	for len(m.calls.WaitForWait) < n {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		m.conds.WaitForWait.Wait()
+	}
+	return nil
+}
+
+// ResetWaitForWait resets the calls made to WaitForWait. Stubs set with OnWaitForWaitCall for calls
+// yet to be made keep their calls, now counted from the reset.
+func (m *EventsClash) ResetWaitForWait() {
+	m.lockWaitForWait.Lock()
+	defer m.lockWaitForWait.Unlock()
+
+	if n := len(m.calls.WaitForWait); n > 0 && m.onCalls.WaitForWait != nil {
+		onCalls := make(map[int]EventsClashWaitForWaitFunc, len(m.onCalls.WaitForWait))
+		for i, fn := range m.onCalls.WaitForWait {
+			if i >= n {
+				onCalls[i-n] = fn
+			}
+		}
+		m.onCalls.WaitForWait = onCalls
+	}
+	m.calls.WaitForWait = nil
+	m.seqs.WaitForWait = nil
+	m.unstubbed.WaitForWait = 0
+}
+
+// OnWaitForWaitCall makes the n'th call to WaitForWait, counting from 0 like WaitForWaitCalls, call fn
+// rather than WaitForWaitFunc.
+func (m *EventsClash) OnWaitForWaitCall(n int, fn EventsClashWaitForWaitFunc) {
+	m.lockWaitForWait.Lock()
+	defer m.lockWaitForWait.Unlock()
+
+	if m.onCalls.WaitForWait == nil {
+		m.onCalls.WaitForWait = make(map[int]EventsClashWaitForWaitFunc)
+	}
+	m.onCalls.WaitForWait[n] = fn
+}
+
+// WaitForWaitReturnsSequence queues fns for the next calls to WaitForWait to call in turn, one
+// per call, after any queued before, then falling back to WaitForWaitFunc. Calls
+// stubbed with OnWaitForWaitCall don't use up the queue.
+func (m *EventsClash) WaitForWaitReturnsSequence(fns ...EventsClashWaitForWaitFunc) {
+	m.lockWaitForWait.Lock()
+	defer m.lockWaitForWait.Unlock()
+
+	m.queued.WaitForWait = append(m.queued.WaitForWait, fns...)
+}
+
+// WithWaitForWait sets WaitForWaitFunc to fn, taking the lock calls to WaitForWait take so it's safe
+// once the mock's shared, and returns the mock.
+func (m *EventsClash) WithWaitForWait(fn EventsClashWaitForWaitFunc) *EventsClash {
+	m.lockWaitForWait.Lock()
+	m.WaitForWaitFunc = fn
+	m.lockWaitForWait.Unlock()
+	return m
+}
+
+// EventsClashSubscribeFunc is the func EventsClash.Subscribe calls.
+type EventsClashSubscribeFunc func(topic string)
+
+// EventsClashSubscribeCall is a call made to EventsClash.Subscribe.
+type EventsClashSubscribeCall struct {
+	Topic string
+
+	// Panic is the value the call panicked with, if it did.
+	Panic interface{}
+}
+
+// Subscribe mocks base method by wrapping the associated func.
+func (m *EventsClash) Subscribe(topic string) {
+	m.lockSubscribe.Lock()
+	fn := m.SubscribeFunc
+	if onCall, ok := m.onCalls.Subscribe[len(m.calls.Subscribe)]; ok {
+		fn = onCall
+		delete(m.onCalls.Subscribe, len(m.calls.Subscribe))
+	} else if len(m.queued.Subscribe) > 0 {
+		fn = m.queued.Subscribe[0]
+		m.queued.Subscribe = m.queued.Subscribe[1:]
+	}
+	if fn == nil {
+		if m.t == nil {
+			m.lockSubscribe.Unlock()
+			panic("mocker: EventsClash.SubscribeFunc is nil but EventsClash.Subscribe was called.")
+		}
+		m.unstubbed.Subscribe++
+	}
+	call := &EventsClashSubscribeCall{
+		Topic: topic,
+	}
+	m.calls.Subscribe = append(m.calls.Subscribe, call)
+	m.seqs.Subscribe = append(m.seqs.Subscribe, mocker.Sequence())
+	if m.conds.Subscribe != nil {
+		m.conds.Subscribe.Broadcast()
+	}
+	m.lockSubscribe.Unlock()
+
+	defer func() {
+		r := recover()
+		m.lockSubscribe.Lock()
+		call.Panic = r
+		m.lockSubscribe.Unlock()
+		m.events.Publish(mocker.CallEvent{
+			Mock:    "EventsClash",
+			Method:  "Subscribe",
+			Args:    []interface{}{topic},
+			Results: []interface{}{},
+			Panic:   r,
+		})
+		if r != nil {
+			panic(r)
+		}
+	}()
+
+	if fn == nil {
+		return
+	}
+
+	fn(topic)
+}
+
+// SubscribeCalled returns true if Subscribe was called at least once.
+func (m *EventsClash) SubscribeCalled() bool {
+	m.lockSubscribe.Lock()
+	defer m.lockSubscribe.Unlock()
+
+	return len(m.calls.Subscribe) > 0
+}
+
+// SubscribeCalls returns the calls made to Subscribe.
+func (m *EventsClash) SubscribeCalls() []EventsClashSubscribeCall {
+	m.lockSubscribe.Lock()
+	defer m.lockSubscribe.Unlock()
+
+	var calls []EventsClashSubscribeCall
+	for _, call := range m.calls.Subscribe {
+		calls = append(calls, *call)
+	}
+	return calls
+}
+
+// SubscribeCallCount returns the number of calls made to Subscribe.
+func (m *EventsClash) SubscribeCallCount() int {
+	m.lockSubscribe.Lock()
+	defer m.lockSubscribe.Unlock()
+
+	return len(m.calls.Subscribe)
+}
+
+// SubscribeCallAt returns the i'th call made to Subscribe, counting from 0, failing the
+// test through t if there weren't that many calls.
+func (m *EventsClash) SubscribeCallAt(t testing.TB, i int) EventsClashSubscribeCall {
+	t.Helper()
+	m.lockSubscribe.Lock()
+	defer m.lockSubscribe.Unlock()
+
+	if i < 0 || i >= len(m.calls.Subscribe) {
+		t.Fatalf("mocker: EventsClash.Subscribe was called %v times, wanted call %v", len(m.calls.Subscribe), i)
+	}
+	return *m.calls.Subscribe[i]
+}
+
+// SubscribeLastCall returns the last call made to Subscribe, failing the test through t
+// if there weren't any.
+func (m *EventsClash) SubscribeLastCall(t testing.TB) EventsClashSubscribeCall {
+	t.Helper()
+	m.lockSubscribe.Lock()
+	defer m.lockSubscribe.Unlock()
+
+	if len(m.calls.Subscribe) == 0 {
+		t.Fatalf("mocker: EventsClash.Subscribe wasn't called")
+	}
+	return *m.calls.Subscribe[len(m.calls.Subscribe)-1]
+}
+
+// SubscribeCallsWhere returns the calls made to Subscribe that fn returns true for.
+func (m *EventsClash) SubscribeCallsWhere(fn func(EventsClashSubscribeCall) bool) []EventsClashSubscribeCall {
+	var calls []EventsClashSubscribeCall
+	for _, call := range m.SubscribeCalls() {
+		if fn(call) {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// AssertSubscribeCalledWith reports through t unless Subscribe was called with args
+// matching the matchers, and returns whether it was.
+func (m *EventsClash) AssertSubscribeCalledWith(t testing.TB, topic match.Matcher) bool {
+	t.Helper()
+	m.lockSubscribe.Lock()
+	defer m.lockSubscribe.Unlock()
+
+	for _, call := range m.calls.Subscribe {
+		if topic.Match(call.Topic) {
+			return true
+		}
+	}
+	t.Errorf("mocker: EventsClash.Subscribe wasn't called with (%v)", topic)
+	return false
+}
+
+// SubscribeCall describes the calls made to Subscribe with args matching args, which
+// may be match.Matchers, for asserting on their order with mocker.InOrder.
+func (m *EventsClash) SubscribeCall(topic interface{}) mocker.Call {
+	m.lockSubscribe.Lock()
+	defer m.lockSubscribe.Unlock()
+
+	var seqs []uint64
+	for i, call := range m.calls.Subscribe {
+		if match.Of(topic).Match(call.Topic) {
+			seqs = append(seqs, m.seqs.Subscribe[i])
+		}
+	}
+	return mocker.Call{
+		Desc: mocker.Describe("EventsClash.Subscribe", topic),
+		Seqs: seqs,
+	}
+}
+
+// WaitForSubscribe blocks until Subscribe has been called at least n times, returning
+// nil, or until ctx is done, returning its error.
+func (m *EventsClash) WaitForSubscribe(ctx context.Context, n int) error {
+	m.lockSubscribe.Lock()
+	defer m.lockSubscribe.Unlock()
+
+	if m.conds.Subscribe == nil {
+		m.conds.Subscribe = sync.NewCond(&m.lockSubscribe)
+	}
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		select {
+		case <-ctx.Done():
+			m.lockSubscribe.Lock()
+			m.conds.Subscribe.Broadcast()
+			m.lockSubscribe.Unlock()
+		case <-stop:
+		}
+	}()
+	for len(m.calls.Subscribe) < n {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		m.conds.Subscribe.Wait()
+	}
+	return nil
+}
+
+// ResetSubscribe resets the calls made to Subscribe. Stubs set with OnSubscribeCall for calls
+// yet to be made keep their calls, now counted from the reset.
+func (m *EventsClash) ResetSubscribe() {
+	m.lockSubscribe.Lock()
+	defer m.lockSubscribe.Unlock()
+
+	if n := len(m.calls.Subscribe); n > 0 && m.onCalls.Subscribe != nil {
+		onCalls := make(map[int]EventsClashSubscribeFunc, len(m.onCalls.Subscribe))
+		for i, fn := range m.onCalls.Subscribe {
+			if i >= n {
+				onCalls[i-n] = fn
+			}
+		}
+		m.onCalls.Subscribe = onCalls
+	}
+	m.calls.Subscribe = nil
+	m.seqs.Subscribe = nil
+	m.unstubbed.Subscribe = 0
+}
+
+// OnSubscribeCall makes the n'th call to Subscribe, counting from 0 like SubscribeCalls, call fn
+// rather than SubscribeFunc.
+func (m *EventsClash) OnSubscribeCall(n int, fn EventsClashSubscribeFunc) {
+	m.lockSubscribe.Lock()
+	defer m.lockSubscribe.Unlock()
+
+	if m.onCalls.Subscribe == nil {
+		m.onCalls.Subscribe = make(map[int]EventsClashSubscribeFunc)
+	}
+	m.onCalls.Subscribe[n] = fn
+}
+
+// SubscribeReturnsSequence queues fns for the next calls to Subscribe to call in turn, one
+// per call, after any queued before, then falling back to SubscribeFunc. Calls
+// stubbed with OnSubscribeCall don't use up the queue.
+func (m *EventsClash) SubscribeReturnsSequence(fns ...EventsClashSubscribeFunc) {
+	m.lockSubscribe.Lock()
+	defer m.lockSubscribe.Unlock()
+
+	m.queued.Subscribe = append(m.queued.Subscribe, fns...)
+}
+
+// WithSubscribe sets SubscribeFunc to fn, taking the lock calls to Subscribe take so it's safe
+// once the mock's shared, and returns the mock.
+func (m *EventsClash) WithSubscribe(fn EventsClashSubscribeFunc) *EventsClash {
+	m.lockSubscribe.Lock()
+	m.SubscribeFunc = fn
+	m.lockSubscribe.Unlock()
+	return m
+}
+
+// Reset resets the calls made to the mocked methods.
+func (m *EventsClash) Reset() {
+	m.ResetVerify()
+	m.ResetClose()
+	m.ResetKeys()
+	m.ResetDo()
+	m.ResetDoCall()
+	m.ResetAll_2()
+	m.ResetStubs_2()
+	m.ResetFoo_2()
+	m.ResetResetFoo()
+	m.ResetWait()
+	m.ResetWaitForWait()
+	m.ResetSubscribe()
+}
+
+// ResetStubs clears the funcs and per-call stubs of the mocked methods.
+func (m *EventsClash) ResetStubs() {
+	m.lockVerify.Lock()
+	m.VerifyFunc = nil
+	m.onCalls.Verify = nil
+	m.queued.Verify = nil
+	m.lockVerify.Unlock()
+	m.lockClose.Lock()
+	m.CloseFunc = nil
+	m.onCalls.Close = nil
+	m.queued.Close = nil
+	m.lockClose.Unlock()
+	m.lockKeys.Lock()
+	m.KeysFunc = nil
+	m.onCalls.Keys = nil
+	m.queued.Keys = nil
+	m.lockKeys.Unlock()
+	m.lockDo.Lock()
+	m.DoFunc = nil
+	m.onCalls.Do = nil
+	m.queued.Do = nil
+	m.lockDo.Unlock()
+	m.lockDoCall.Lock()
+	m.DoCallFunc = nil
+	m.onCalls.DoCall = nil
+	m.queued.DoCall = nil
+	m.lockDoCall.Unlock()
+	m.lockAll.Lock()
+	m.AllFunc = nil
+	m.onCalls.All = nil
+	m.queued.All = nil
+	m.lockAll.Unlock()
+	m.lockStubs.Lock()
+	m.StubsFunc = nil
+	m.onCalls.Stubs = nil
+	m.queued.Stubs = nil
+	m.lockStubs.Unlock()
+	m.lockFoo.Lock()
+	m.FooFunc = nil
+	m.onCalls.Foo = nil
+	m.queued.Foo = nil
+	m.lockFoo.Unlock()
+	m.lockResetFoo.Lock()
+	m.ResetFooFunc = nil
+	m.onCalls.ResetFoo = nil
+	m.queued.ResetFoo = nil
+	m.lockResetFoo.Unlock()
+	m.lockWait.Lock()
+	m.WaitFunc = nil
+	m.onCalls.Wait = nil
+	m.queued.Wait = nil
+	m.lockWait.Unlock()
+	m.lockWaitForWait.Lock()
+	m.WaitForWaitFunc = nil
+	m.onCalls.WaitForWait = nil
+	m.queued.WaitForWait = nil
+	m.lockWaitForWait.Unlock()
+	m.lockSubscribe.Lock()
+	m.SubscribeFunc = nil
+	m.onCalls.Subscribe = nil
+	m.queued.Subscribe = nil
+	m.lockSubscribe.Unlock()
+}
+
+// ResetAll resets the calls made to the mocked methods and clears their
+// funcs and per-call stubs.
+func (m *EventsClash) ResetAll() {
+	m.Reset()
+	m.ResetStubs()
+}
+
+// Verify_2 reports through t any calls made to methods without a func, funcs
+// and per-call stubs that weren't called, and unmet expectations. It returns
+// whether there were none.
+func (m *EventsClash) Verify_2(t testing.TB) bool {
+	t.Helper()
+	ok := true
+	m.lockVerify.Lock()
+	if m.unstubbed.Verify > 0 {
+		t.Errorf("mocker: EventsClash.Verify was called %v times without a func", m.unstubbed.Verify)
+		ok = false
+	}
+	if m.VerifyFunc != nil && len(m.calls.Verify) == 0 {
+		t.Errorf("mocker: EventsClash.VerifyFunc is set but Verify wasn't called")
+		ok = false
+	}
+	for n := range m.onCalls.Verify {
+		t.Errorf("mocker: EventsClash.Verify's stub for call %v wasn't called", n)
+		ok = false
+	}
+	if len(m.queued.Verify) > 0 {
+		t.Errorf("mocker: EventsClash.Verify's last %v stubs in sequence weren't called", len(m.queued.Verify))
+		ok = false
+	}
+	m.lockVerify.Unlock()
+	m.lockClose.Lock()
+	if m.unstubbed.Close > 0 {
+		t.Errorf("mocker: EventsClash.Close was called %v times without a func", m.unstubbed.Close)
+		ok = false
+	}
+	if m.CloseFunc != nil && len(m.calls.Close) == 0 {
+		t.Errorf("mocker: EventsClash.CloseFunc is set but Close wasn't called")
+		ok = false
+	}
+	for n := range m.onCalls.Close {
+		t.Errorf("mocker: EventsClash.Close's stub for call %v wasn't called", n)
+		ok = false
+	}
+	if len(m.queued.Close) > 0 {
+		t.Errorf("mocker: EventsClash.Close's last %v stubs in sequence weren't called", len(m.queued.Close))
+		ok = false
+	}
+	m.lockClose.Unlock()
+	m.lockKeys.Lock()
+	if m.unstubbed.Keys > 0 {
+		t.Errorf("mocker: EventsClash.Keys was called %v times without a func", m.unstubbed.Keys)
+		ok = false
+	}
+	if m.KeysFunc != nil && len(m.calls.Keys) == 0 {
+		t.Errorf("mocker: EventsClash.KeysFunc is set but Keys wasn't called")
+		ok = false
+	}
+	for n := range m.onCalls.Keys {
+		t.Errorf("mocker: EventsClash.Keys's stub for call %v wasn't called", n)
+		ok = false
+	}
+	if len(m.queued.Keys) > 0 {
+		t.Errorf("mocker: EventsClash.Keys's last %v stubs in sequence weren't called", len(m.queued.Keys))
+		ok = false
+	}
+	m.lockKeys.Unlock()
+	m.lockDo.Lock()
+	if m.unstubbed.Do > 0 {
+		t.Errorf("mocker: EventsClash.Do was called %v times without a func", m.unstubbed.Do)
+		ok = false
+	}
+	if m.DoFunc != nil && len(m.calls.Do) == 0 {
+		t.Errorf("mocker: EventsClash.DoFunc is set but Do wasn't called")
+		ok = false
+	}
+	for n := range m.onCalls.Do {
+		t.Errorf("mocker: EventsClash.Do's stub for call %v wasn't called", n)
+		ok = false
+	}
+	if len(m.queued.Do) > 0 {
+		t.Errorf("mocker: EventsClash.Do's last %v stubs in sequence weren't called", len(m.queued.Do))
+		ok = false
+	}
+	m.lockDo.Unlock()
+	m.lockDoCall.Lock()
+	if m.unstubbed.DoCall > 0 {
+		t.Errorf("mocker: EventsClash.DoCall was called %v times without a func", m.unstubbed.DoCall)
+		ok = false
+	}
+	if m.DoCallFunc != nil && len(m.calls.DoCall) == 0 {
+		t.Errorf("mocker: EventsClash.DoCallFunc is set but DoCall wasn't called")
+		ok = false
+	}
+	for n := range m.onCalls.DoCall {
+		t.Errorf("mocker: EventsClash.DoCall's stub for call %v wasn't called", n)
+		ok = false
+	}
+	if len(m.queued.DoCall) > 0 {
+		t.Errorf("mocker: EventsClash.DoCall's last %v stubs in sequence weren't called", len(m.queued.DoCall))
+		ok = false
+	}
+	m.lockDoCall.Unlock()
+	m.lockAll.Lock()
+	if m.unstubbed.All > 0 {
+		t.Errorf("mocker: EventsClash.All was called %v times without a func", m.unstubbed.All)
+		ok = false
+	}
+	if m.AllFunc != nil && len(m.calls.All) == 0 {
+		t.Errorf("mocker: EventsClash.AllFunc is set but All wasn't called")
+		ok = false
+	}
+	for n := range m.onCalls.All {
+		t.Errorf("mocker: EventsClash.All's stub for call %v wasn't called", n)
+		ok = false
+	}
+	if len(m.queued.All) > 0 {
+		t.Errorf("mocker: EventsClash.All's last %v stubs in sequence weren't called", len(m.queued.All))
+		ok = false
+	}
+	m.lockAll.Unlock()
+	m.lockStubs.Lock()
+	if m.unstubbed.Stubs > 0 {
+		t.Errorf("mocker: EventsClash.Stubs was called %v times without a func", m.unstubbed.Stubs)
+		ok = false
+	}
+	if m.StubsFunc != nil && len(m.calls.Stubs) == 0 {
+		t.Errorf("mocker: EventsClash.StubsFunc is set but Stubs wasn't called")
+		ok = false
+	}
+	for n := range m.onCalls.Stubs {
+		t.Errorf("mocker: EventsClash.Stubs's stub for call %v wasn't called", n)
+		ok = false
+	}
+	if len(m.queued.Stubs) > 0 {
+		t.Errorf("mocker: EventsClash.Stubs's last %v stubs in sequence weren't called", len(m.queued.Stubs))
+		ok = false
+	}
+	m.lockStubs.Unlock()
+	m.lockFoo.Lock()
+	if m.unstubbed.Foo > 0 {
+		t.Errorf("mocker: EventsClash.Foo was called %v times without a func", m.unstubbed.Foo)
+		ok = false
+	}
+	if m.FooFunc != nil && len(m.calls.Foo) == 0 {
+		t.Errorf("mocker: EventsClash.FooFunc is set but Foo wasn't called")
+		ok = false
+	}
+	for n := range m.onCalls.Foo {
+		t.Errorf("mocker: EventsClash.Foo's stub for call %v wasn't called", n)
+		ok = false
+	}
+	if len(m.queued.Foo) > 0 {
+		t.Errorf("mocker: EventsClash.Foo's last %v stubs in sequence weren't called", len(m.queued.Foo))
+		ok = false
+	}
+	m.lockFoo.Unlock()
+	m.lockResetFoo.Lock()
+	if m.unstubbed.ResetFoo > 0 {
+		t.Errorf("mocker: EventsClash.ResetFoo was called %v times without a func", m.unstubbed.ResetFoo)
+		ok = false
+	}
+	if m.ResetFooFunc != nil && len(m.calls.ResetFoo) == 0 {
+		t.Errorf("mocker: EventsClash.ResetFooFunc is set but ResetFoo wasn't called")
+		ok = false
+	}
+	for n := range m.onCalls.ResetFoo {
+		t.Errorf("mocker: EventsClash.ResetFoo's stub for call %v wasn't called", n)
+		ok = false
+	}
+	if len(m.queued.ResetFoo) > 0 {
+		t.Errorf("mocker: EventsClash.ResetFoo's last %v stubs in sequence weren't called", len(m.queued.ResetFoo))
+		ok = false
+	}
+	m.lockResetFoo.Unlock()
+	m.lockWait.Lock()
+	if m.unstubbed.Wait > 0 {
+		t.Errorf("mocker: EventsClash.Wait was called %v times without a func", m.unstubbed.Wait)
+		ok = false
+	}
+	if m.WaitFunc != nil && len(m.calls.Wait) == 0 {
+		t.Errorf("mocker: EventsClash.WaitFunc is set but Wait wasn't called")
+		ok = false
+	}
+	for n := range m.onCalls.Wait {
+		t.Errorf("mocker: EventsClash.Wait's stub for call %v wasn't called", n)
+		ok = false
+	}
+	if len(m.queued.Wait) > 0 {
+		t.Errorf("mocker: EventsClash.Wait's last %v stubs in sequence weren't called", len(m.queued.Wait))
+		ok = false
+	}
+	m.lockWait.Unlock()
+	m.lockWaitForWait.Lock()
+	if m.unstubbed.WaitForWait > 0 {
+		t.Errorf("mocker: EventsClash.WaitForWait was called %v times without a func", m.unstubbed.WaitForWait)
+		ok = false
+	}
+	if m.WaitForWaitFunc != nil && len(m.calls.WaitForWait) == 0 {
+		t.Errorf("mocker: EventsClash.WaitForWaitFunc is set but WaitForWait wasn't called")
+		ok = false
+	}
+	for n := range m.onCalls.WaitForWait {
+		t.Errorf("mocker: EventsClash.WaitForWait's stub for call %v wasn't called", n)
+		ok = false
+	}
+	if len(m.queued.WaitForWait) > 0 {
+		t.Errorf("mocker: EventsClash.WaitForWait's last %v stubs in sequence weren't called", len(m.queued.WaitForWait))
+		ok = false
+	}
+	m.lockWaitForWait.Unlock()
+	m.lockSubscribe.Lock()
+	if m.unstubbed.Subscribe > 0 {
+		t.Errorf("mocker: EventsClash.Subscribe was called %v times without a func", m.unstubbed.Subscribe)
+		ok = false
+	}
+	if m.SubscribeFunc != nil && len(m.calls.Subscribe) == 0 {
+		t.Errorf("mocker: EventsClash.SubscribeFunc is set but Subscribe wasn't called")
+		ok = false
+	}
+	for n := range m.onCalls.Subscribe {
+		t.Errorf("mocker: EventsClash.Subscribe's stub for call %v wasn't called", n)
+		ok = false
+	}
+	if len(m.queued.Subscribe) > 0 {
+		t.Errorf("mocker: EventsClash.Subscribe's last %v stubs in sequence weren't called", len(m.queued.Subscribe))
+		ok = false
+	}
+	m.lockSubscribe.Unlock()
+	return ok
+}
+
+// Subscribe_2 returns a channel receiving the calls made to the mock from now
+// on, once they've returned or panicked, and a func unsubscribing it.
+// Unsubscribing closes the channel, and subscribers are unsubscribed
+// when the test's done.
+func (m *EventsClash) Subscribe_2() (<-chan mocker.CallEvent, func()) {
+	return m.events.Subscribe()
+}
@@ -0,0 +1,136 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/travisjeffery/mocker"
+)
+
+func TestEventsIfaceSubscribe(t *testing.T) {
+	m := NewEventsIface(t)
+	m.FiveFunc = func(ctx context.Context, id string) (int, error) {
+		return len(id), errors.New("five")
+	}
+
+	calls, unsubscribe := m.Subscribe()
+	defer unsubscribe()
+	ctx := context.Background()
+	go m.Five(ctx, "travis")
+
+	select {
+	case e := <-calls:
+		want := mocker.CallEvent{
+			Mock:    "EventsIface",
+			Method:  "Five",
+			Args:    []interface{}{ctx, "travis"},
+			Results: []interface{}{6, errors.New("five")},
+		}
+		if !reflect.DeepEqual(e, want) {
+			t.Errorf("got %#v, want %#v", e, want)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for call")
+	}
+}
+
+func TestEventsIfaceSubscribeOrder(t *testing.T) {
+	m := NewEventsIface(t)
+	m.TwoFunc = func(a, b int) int {
+		return a + b
+	}
+
+	calls, unsubscribe := m.Subscribe()
+	defer unsubscribe()
+	for i := 0; i < 10; i++ {
+		m.Two(i, i)
+	}
+
+	for i := 0; i < 10; i++ {
+		select {
+		case e := <-calls:
+			if got := e.Args[0]; got != i {
+				t.Errorf("got call with %v, want %v", got, i)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("timed out waiting for call %v", i)
+		}
+	}
+}
+
+func TestEventsIfaceUnsubscribe(t *testing.T) {
+	m := NewEventsIface(t)
+	m.TwoFunc = func(a, b int) int {
+		return a + b
+	}
+
+	calls, unsubscribe := m.Subscribe()
+	m.Two(1, 1)
+	unsubscribe()
+	unsubscribe()
+	m.Two(2, 2)
+
+	// The queued call may or may not have been taken by the sender before
+	// the unsubscribe, but the one after it is never sent, and the channel's
+	// closed.
+	drained := make(chan struct{})
+	go func() {
+		defer close(drained)
+		for e := range calls {
+			if got := e.Args[0]; got != 1 {
+				t.Errorf("got call with %v after unsubscribing", got)
+			}
+		}
+	}()
+	select {
+	case <-drained:
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for the channel to close")
+	}
+}
+
+func TestEventsIfaceCleanupCloses(t *testing.T) {
+	var calls <-chan mocker.CallEvent
+	t.Run("subscribe", func(t *testing.T) {
+		m := NewEventsIface(t)
+		m.TwoFunc = func(a, b int) int {
+			return a + b
+		}
+		calls, _ = m.Subscribe()
+		m.Two(1, 1)
+	})
+
+	drained := make(chan struct{})
+	go func() {
+		defer close(drained)
+		for range calls {
+		}
+	}()
+	select {
+	case <-drained:
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for the channel to close")
+	}
+}
+
+func TestEventsClashSubscribe(t *testing.T) {
+	m := NewEventsClash(t)
+	m.SubscribeFunc = func(topic string) {}
+
+	// The mock's Subscribe is renamed rather than clashing.
+	calls, unsubscribe := m.Subscribe_2()
+	defer unsubscribe()
+	go m.Subscribe("topic")
+
+	select {
+	case e := <-calls:
+		if e.Method != "Subscribe" {
+			t.Errorf("got call to %v, want Subscribe", e.Method)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for call")
+	}
+}
@@ -0,0 +1,137 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"runtime"
+	"testing"
+
+	"github.com/travisjeffery/mocker/test/c"
+)
+
+// fakeT records the failures reported through it and the cleanups registered
+// with it.
+type fakeT struct {
+	testing.TB
+	errors   []string
+	cleanups []func()
+}
+
+func (t *fakeT) Helper() {}
+
+// Fatalf records the failure and stops the goroutine, so must be called on one
+// started by the test, like the testing package's.
+func (t *fakeT) Fatalf(format string, args ...interface{}) {
+	t.Errorf(format, args...)
+	runtime.Goexit()
+}
+
+func (t *fakeT) Cleanup(f func()) {
+	t.cleanups = append(t.cleanups, f)
+}
+
+// cleanup runs the registered cleanups, like the testing package does when
+// the test's done.
+func (t *fakeT) cleanup() {
+	for i := len(t.cleanups) - 1; i >= 0; i-- {
+		t.cleanups[i]()
+	}
+}
+
+func (t *fakeT) Errorf(format string, args ...interface{}) {
+	t.errors = append(t.errors, fmt.Sprintf(format, args...))
+}
+
+func TestExpectIface(t *testing.T) {
+	iface := &ExpectIface{}
+	iface.ExpectFive(context.Background(), "a").Return(1, nil).Times(2)
+	iface.ExpectFive(context.Background(), "b").Return(0, fmt.Errorf("b"))
+	iface.ExpectFour(1)
+	for i := 0; i < 2; i++ {
+		if n, err := iface.Five(context.Background(), "a"); n != 1 || err != nil {
+			t.Errorf("Five(a) = %v, %v, want %v, %v", n, err, 1, nil)
+		}
+	}
+	if _, err := iface.Five(context.Background(), "b"); err == nil || err.Error() != "b" {
+		t.Errorf("Five(b) err = %v, want %v", err, "b")
+	}
+	iface.Four(c.Int(1))
+	if !iface.AssertExpectations(t) {
+		t.Errorf("AssertExpectations() = %v, want %v", false, true)
+	}
+	if len(iface.FiveCalls()) != 3 {
+		t.Errorf("fivecalls = %v, want %v", len(iface.FiveCalls()), 3)
+	}
+}
+
+func TestExpectIfaceFailures(t *testing.T) {
+	iface := &ExpectIface{
+		FiveFunc: func(ctx context.Context, id string) (int, error) {
+			return 0, nil
+		},
+	}
+	iface.ExpectFive(context.Background(), "a")
+	iface.ExpectFour(1).Times(2)
+	iface.Five(context.Background(), "a")
+	iface.Five(context.Background(), "a")
+	iface.Five(context.Background(), "c")
+	iface.Four(c.Int(1))
+
+	ft := &fakeT{}
+	if iface.AssertExpectations(ft) {
+		t.Errorf("AssertExpectations() = %v, want %v", true, false)
+	}
+	want := []string{
+		"mocker: expected Four(1) 2 times, got 1",
+		`mocker: unexpected call to Five(context.Background, "a")`,
+		`mocker: unexpected call to Five(context.Background, "c")`,
+	}
+	if len(ft.errors) != len(want) {
+		t.Fatalf("errors = %v, want %v", ft.errors, want)
+	}
+	for i, w := range want {
+		if ft.errors[i] != w {
+			t.Errorf("errors[%d] = %v, want %v", i, ft.errors[i], w)
+		}
+	}
+}
+
+func TestNewMockIface(t *testing.T) {
+	iface := NewMockIface(t)
+	iface.TwoFunc = func(x, y int) int {
+		return x + y
+	}
+	if z := iface.Two(1, 2); z != 3 {
+		t.Errorf("z = %v, want %v", z, 3)
+	}
+}
+
+func TestNewMockIfaceVerify(t *testing.T) {
+	ft := &fakeT{}
+	iface := NewMockIface(ft)
+	iface.TwoFunc = func(x, y int) int {
+		return x + y
+	}
+	iface.OnFiveCall(1, func(ctx context.Context, id string) (int, error) {
+		return 1, nil
+	})
+	if n, err := iface.Five(context.Background(), "id"); n != 0 || err != nil {
+		t.Errorf("Five() = %v, %v, want %v, %v", n, err, 0, nil)
+	}
+	iface.Four(c.Int(1))
+	iface.Four(c.Int(2))
+	if len(ft.errors) != 0 {
+		t.Errorf("errors = %v before cleanup, want none", ft.errors)
+	}
+	ft.cleanup()
+	want := []string{
+		"mocker: MockIface.TwoFunc is set but Two wasn't called",
+		"mocker: MockIface.Four was called 2 times without a func",
+		"mocker: MockIface.Five was called 1 times without a func",
+		"mocker: MockIface.Five's stub for call 1 wasn't called",
+	}
+	if !reflect.DeepEqual(ft.errors, want) {
+		t.Errorf("errors = %v, want %v", ft.errors, want)
+	}
+}
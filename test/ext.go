@@ -0,0 +1,1526 @@
+// Code generated by mocker. DO NOT EDIT.
+// github.com/travisjeffery/mocker
+// Source: test/in.go
+
+package test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/travisjeffery/mocker"
+	"github.com/travisjeffery/mocker/pkg/mocker/match"
+	av1 "github.com/travisjeffery/mocker/test/a"
+	bv1 "github.com/travisjeffery/mocker/test/b"
+	"github.com/travisjeffery/mocker/test/c"
+)
+
+// ExtIface is a mock of Iface interface
+type ExtIface struct {
+	lockOne sync.Mutex
+	OneFunc ExtIfaceOneFunc
+
+	lockTwo sync.Mutex
+	TwoFunc ExtIfaceTwoFunc
+
+	lockThree sync.Mutex
+	ThreeFunc ExtIfaceThreeFunc
+
+	lockFour sync.Mutex
+	FourFunc ExtIfaceFourFunc
+
+	lockFive sync.Mutex
+	FiveFunc ExtIfaceFiveFunc
+
+	calls struct {
+		One   []*ExtIfaceOneCall
+		Two   []*ExtIfaceTwoCall
+		Three []*ExtIfaceThreeCall
+		Four  []*ExtIfaceFourCall
+		Five  []*ExtIfaceFiveCall
+	}
+	seqs struct {
+		One   []uint64
+		Two   []uint64
+		Three []uint64
+		Four  []uint64
+		Five  []uint64
+	}
+	conds struct {
+		One   *sync.Cond
+		Two   *sync.Cond
+		Three *sync.Cond
+		Four  *sync.Cond
+		Five  *sync.Cond
+	}
+	onCalls struct {
+		One   map[int]ExtIfaceOneFunc
+		Two   map[int]ExtIfaceTwoFunc
+		Three map[int]ExtIfaceThreeFunc
+		Four  map[int]ExtIfaceFourFunc
+		Five  map[int]ExtIfaceFiveFunc
+	}
+	queued struct {
+		One   []ExtIfaceOneFunc
+		Two   []ExtIfaceTwoFunc
+		Three []ExtIfaceThreeFunc
+		Four  []ExtIfaceFourFunc
+		Five  []ExtIfaceFiveFunc
+	}
+	unstubbed struct {
+		One   int
+		Two   int
+		Three int
+		Four  int
+		Five  int
+	}
+	t testing.TB
+
+	// Name names the mock in failures, rather than its type.
+	Name string
+}
+
+// ExtIface must implement Iface, so the build breaks if it's stale.
+var _ Iface = (*ExtIface)(nil)
+
+// NewExtIface returns a ExtIface that reports calls to methods without a
+// func through t, rather than panicking, and verifies it was used as
+// stubbed when the test's done.
+func NewExtIface(t testing.TB) *ExtIface {
+	m := &ExtIface{t: t}
+	t.Cleanup(func() {
+		m.Verify(t)
+	})
+	return m
+}
+
+// ExtIfaceOneFunc is the func ExtIface.One calls.
+type ExtIfaceOneFunc func(str string, variadic ...string) (string, []string)
+
+// ExtIfaceOneCall is a call made to ExtIface.One.
+type ExtIfaceOneCall struct {
+	Str      string
+	Variadic []string
+
+	Ret0 string
+	Ret1 []string
+
+	// Panic is the value the call panicked with, if it did.
+	Panic interface{}
+}
+
+// One mocks base method by wrapping the associated func.
+func (m *ExtIface) One(str string, variadic ...string) (string, []string) {
+	m.lockOne.Lock()
+	fn := m.OneFunc
+	if onCall, ok := m.onCalls.One[len(m.calls.One)]; ok {
+		fn = onCall
+		delete(m.onCalls.One, len(m.calls.One))
+	} else if len(m.queued.One) > 0 {
+		fn = m.queued.One[0]
+		m.queued.One = m.queued.One[1:]
+	}
+	if fn == nil {
+		if m.t == nil {
+			m.lockOne.Unlock()
+			panic("mocker: ExtIface.OneFunc is nil but ExtIface.One was called.")
+		}
+		m.unstubbed.One++
+	}
+	call := &ExtIfaceOneCall{
+		Str:      str,
+		Variadic: variadic,
+	}
+	m.calls.One = append(m.calls.One, call)
+	m.seqs.One = append(m.seqs.One, mocker.Sequence())
+	if m.conds.One != nil {
+		m.conds.One.Broadcast()
+	}
+	m.lockOne.Unlock()
+
+	var ret0 string
+	var ret1 []string
+	defer func() {
+		r := recover()
+		m.lockOne.Lock()
+		call.Ret0 = ret0
+		call.Ret1 = ret1
+		call.Panic = r
+		m.lockOne.Unlock()
+		if r != nil {
+			panic(r)
+		}
+	}()
+
+	if fn == nil {
+		return ret0, ret1
+	}
+
+	ret0, ret1 = fn(str, variadic...)
+	return ret0, ret1
+}
+
+// OneCalled returns true if One was called at least once.
+func (m *ExtIface) OneCalled() bool {
+	m.lockOne.Lock()
+	defer m.lockOne.Unlock()
+
+	return len(m.calls.One) > 0
+}
+
+// OneCalls returns the calls made to One.
+func (m *ExtIface) OneCalls() []ExtIfaceOneCall {
+	m.lockOne.Lock()
+	defer m.lockOne.Unlock()
+
+	var calls []ExtIfaceOneCall
+	for _, call := range m.calls.One {
+		calls = append(calls, *call)
+	}
+	return calls
+}
+
+// OneCallCount returns the number of calls made to One.
+func (m *ExtIface) OneCallCount() int {
+	m.lockOne.Lock()
+	defer m.lockOne.Unlock()
+
+	return len(m.calls.One)
+}
+
+// OneCallAt returns the i'th call made to One, counting from 0, failing the
+// test through t if there weren't that many calls.
+func (m *ExtIface) OneCallAt(t testing.TB, i int) ExtIfaceOneCall {
+	t.Helper()
+	m.lockOne.Lock()
+	defer m.lockOne.Unlock()
+
+	if i < 0 || i >= len(m.calls.One) {
+		t.Fatalf("mocker: ExtIface.One was called %v times, wanted call %v", len(m.calls.One), i)
+	}
+	return *m.calls.One[i]
+}
+
+// OneLastCall returns the last call made to One, failing the test through t
+// if there weren't any.
+func (m *ExtIface) OneLastCall(t testing.TB) ExtIfaceOneCall {
+	t.Helper()
+	m.lockOne.Lock()
+	defer m.lockOne.Unlock()
+
+	if len(m.calls.One) == 0 {
+		t.Fatalf("mocker: ExtIface.One wasn't called")
+	}
+	return *m.calls.One[len(m.calls.One)-1]
+}
+
+// OneCallsWhere returns the calls made to One that fn returns true for.
+func (m *ExtIface) OneCallsWhere(fn func(ExtIfaceOneCall) bool) []ExtIfaceOneCall {
+	var calls []ExtIfaceOneCall
+	for _, call := range m.OneCalls() {
+		if fn(call) {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// AssertOneCalledWith reports through t unless One was called with args
+// matching the matchers, and returns whether it was.
+func (m *ExtIface) AssertOneCalledWith(t testing.TB, str, variadic match.Matcher) bool {
+	t.Helper()
+	m.lockOne.Lock()
+	defer m.lockOne.Unlock()
+
+	for _, call := range m.calls.One {
+		if str.Match(call.Str) && variadic.Match(call.Variadic) {
+			return true
+		}
+	}
+	t.Errorf("mocker: ExtIface.One wasn't called with (%v, %v)", str, variadic)
+	return false
+}
+
+// OneCall describes the calls made to One with args matching args, which
+// may be match.Matchers, for asserting on their order with mocker.InOrder.
+func (m *ExtIface) OneCall(str, variadic interface{}) mocker.Call {
+	m.lockOne.Lock()
+	defer m.lockOne.Unlock()
+
+	var seqs []uint64
+	for i, call := range m.calls.One {
+		if match.Of(str).Match(call.Str) && match.Of(variadic).Match(call.Variadic) {
+			seqs = append(seqs, m.seqs.One[i])
+		}
+	}
+	return mocker.Call{
+		Desc: mocker.Describe("ExtIface.One", str, variadic),
+		Seqs: seqs,
+	}
+}
+
+// WaitForOne blocks until One has been called at least n times, returning
+// nil, or until ctx is done, returning its error.
+func (m *ExtIface) WaitForOne(ctx context.Context, n int) error {
+	m.lockOne.Lock()
+	defer m.lockOne.Unlock()
+
+	if m.conds.One == nil {
+		m.conds.One = sync.NewCond(&m.lockOne)
+	}
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		select {
+		case <-ctx.Done():
+			m.lockOne.Lock()
+			m.conds.One.Broadcast()
+			m.lockOne.Unlock()
+		case <-stop:
+		}
+	}()
+	for len(m.calls.One) < n {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		m.conds.One.Wait()
+	}
+	return nil
+}
+
+// ResetOne resets the calls made to One. Stubs set with OnOneCall for calls
+// yet to be made keep their calls, now counted from the reset.
+func (m *ExtIface) ResetOne() {
+	m.lockOne.Lock()
+	defer m.lockOne.Unlock()
+
+	if n := len(m.calls.One); n > 0 && m.onCalls.One != nil {
+		onCalls := make(map[int]ExtIfaceOneFunc, len(m.onCalls.One))
+		for i, fn := range m.onCalls.One {
+			if i >= n {
+				onCalls[i-n] = fn
+			}
+		}
+		m.onCalls.One = onCalls
+	}
+	m.calls.One = nil
+	m.seqs.One = nil
+	m.unstubbed.One = 0
+}
+
+// OnOneCall makes the n'th call to One, counting from 0 like OneCalls, call fn
+// rather than OneFunc.
+func (m *ExtIface) OnOneCall(n int, fn ExtIfaceOneFunc) {
+	m.lockOne.Lock()
+	defer m.lockOne.Unlock()
+
+	if m.onCalls.One == nil {
+		m.onCalls.One = make(map[int]ExtIfaceOneFunc)
+	}
+	m.onCalls.One[n] = fn
+}
+
+// OneReturnsSequence queues fns for the next calls to One to call in turn, one
+// per call, after any queued before, then falling back to OneFunc. Calls
+// stubbed with OnOneCall don't use up the queue.
+func (m *ExtIface) OneReturnsSequence(fns ...ExtIfaceOneFunc) {
+	m.lockOne.Lock()
+	defer m.lockOne.Unlock()
+
+	m.queued.One = append(m.queued.One, fns...)
+}
+
+// WithOne sets OneFunc to fn, taking the lock calls to One take so it's safe
+// once the mock's shared, and returns the mock.
+func (m *ExtIface) WithOne(fn ExtIfaceOneFunc) *ExtIface {
+	m.lockOne.Lock()
+	m.OneFunc = fn
+	m.lockOne.Unlock()
+	return m
+}
+
+// AssertOneCallCount reports through t if One wasn't called n times.
+func (m *ExtIface) AssertOneCallCount(t testing.TB, n int) bool {
+	t.Helper()
+	name := m.Name
+	if name == "" {
+		name = "ExtIface"
+	}
+	if got := m.OneCallCount(); got != n {
+		t.Errorf("%v.One was called %v times, want %v", name, got, n)
+		return false
+	}
+	return true
+}
+
+// ExtIfaceTwoFunc is the func ExtIface.Two calls.
+type ExtIfaceTwoFunc func(arg0, arg1 int) int
+
+// ExtIfaceTwoCall is a call made to ExtIface.Two.
+type ExtIfaceTwoCall struct {
+	Arg0 int
+	Arg1 int
+
+	Ret0 int
+
+	// Panic is the value the call panicked with, if it did.
+	Panic interface{}
+}
+
+// Two mocks base method by wrapping the associated func.
+func (m *ExtIface) Two(arg0, arg1 int) int {
+	m.lockTwo.Lock()
+	fn := m.TwoFunc
+	if onCall, ok := m.onCalls.Two[len(m.calls.Two)]; ok {
+		fn = onCall
+		delete(m.onCalls.Two, len(m.calls.Two))
+	} else if len(m.queued.Two) > 0 {
+		fn = m.queued.Two[0]
+		m.queued.Two = m.queued.Two[1:]
+	}
+	if fn == nil {
+		if m.t == nil {
+			m.lockTwo.Unlock()
+			panic("mocker: ExtIface.TwoFunc is nil but ExtIface.Two was called.")
+		}
+		m.unstubbed.Two++
+	}
+	call := &ExtIfaceTwoCall{
+		Arg0: arg0,
+		Arg1: arg1,
+	}
+	m.calls.Two = append(m.calls.Two, call)
+	m.seqs.Two = append(m.seqs.Two, mocker.Sequence())
+	if m.conds.Two != nil {
+		m.conds.Two.Broadcast()
+	}
+	m.lockTwo.Unlock()
+
+	var ret0 int
+	defer func() {
+		r := recover()
+		m.lockTwo.Lock()
+		call.Ret0 = ret0
+		call.Panic = r
+		m.lockTwo.Unlock()
+		if r != nil {
+			panic(r)
+		}
+	}()
+
+	if fn == nil {
+		return ret0
+	}
+
+	ret0 = fn(arg0, arg1)
+	return ret0
+}
+
+// TwoCalled returns true if Two was called at least once.
+func (m *ExtIface) TwoCalled() bool {
+	m.lockTwo.Lock()
+	defer m.lockTwo.Unlock()
+
+	return len(m.calls.Two) > 0
+}
+
+// TwoCalls returns the calls made to Two.
+func (m *ExtIface) TwoCalls() []ExtIfaceTwoCall {
+	m.lockTwo.Lock()
+	defer m.lockTwo.Unlock()
+
+	var calls []ExtIfaceTwoCall
+	for _, call := range m.calls.Two {
+		calls = append(calls, *call)
+	}
+	return calls
+}
+
+// TwoCallCount returns the number of calls made to Two.
+func (m *ExtIface) TwoCallCount() int {
+	m.lockTwo.Lock()
+	defer m.lockTwo.Unlock()
+
+	return len(m.calls.Two)
+}
+
+// TwoCallAt returns the i'th call made to Two, counting from 0, failing the
+// test through t if there weren't that many calls.
+func (m *ExtIface) TwoCallAt(t testing.TB, i int) ExtIfaceTwoCall {
+	t.Helper()
+	m.lockTwo.Lock()
+	defer m.lockTwo.Unlock()
+
+	if i < 0 || i >= len(m.calls.Two) {
+		t.Fatalf("mocker: ExtIface.Two was called %v times, wanted call %v", len(m.calls.Two), i)
+	}
+	return *m.calls.Two[i]
+}
+
+// TwoLastCall returns the last call made to Two, failing the test through t
+// if there weren't any.
+func (m *ExtIface) TwoLastCall(t testing.TB) ExtIfaceTwoCall {
+	t.Helper()
+	m.lockTwo.Lock()
+	defer m.lockTwo.Unlock()
+
+	if len(m.calls.Two) == 0 {
+		t.Fatalf("mocker: ExtIface.Two wasn't called")
+	}
+	return *m.calls.Two[len(m.calls.Two)-1]
+}
+
+// TwoCallsWhere returns the calls made to Two that fn returns true for.
+func (m *ExtIface) TwoCallsWhere(fn func(ExtIfaceTwoCall) bool) []ExtIfaceTwoCall {
+	var calls []ExtIfaceTwoCall
+	for _, call := range m.TwoCalls() {
+		if fn(call) {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// AssertTwoCalledWith reports through t unless Two was called with args
+// matching the matchers, and returns whether it was.
+func (m *ExtIface) AssertTwoCalledWith(t testing.TB, arg0, arg1 match.Matcher) bool {
+	t.Helper()
+	m.lockTwo.Lock()
+	defer m.lockTwo.Unlock()
+
+	for _, call := range m.calls.Two {
+		if arg0.Match(call.Arg0) && arg1.Match(call.Arg1) {
+			return true
+		}
+	}
+	t.Errorf("mocker: ExtIface.Two wasn't called with (%v, %v)", arg0, arg1)
+	return false
+}
+
+// TwoCall describes the calls made to Two with args matching args, which
+// may be match.Matchers, for asserting on their order with mocker.InOrder.
+func (m *ExtIface) TwoCall(arg0, arg1 interface{}) mocker.Call {
+	m.lockTwo.Lock()
+	defer m.lockTwo.Unlock()
+
+	var seqs []uint64
+	for i, call := range m.calls.Two {
+		if match.Of(arg0).Match(call.Arg0) && match.Of(arg1).Match(call.Arg1) {
+			seqs = append(seqs, m.seqs.Two[i])
+		}
+	}
+	return mocker.Call{
+		Desc: mocker.Describe("ExtIface.Two", arg0, arg1),
+		Seqs: seqs,
+	}
+}
+
+// WaitForTwo blocks until Two has been called at least n times, returning
+// nil, or until ctx is done, returning its error.
+func (m *ExtIface) WaitForTwo(ctx context.Context, n int) error {
+	m.lockTwo.Lock()
+	defer m.lockTwo.Unlock()
+
+	if m.conds.Two == nil {
+		m.conds.Two = sync.NewCond(&m.lockTwo)
+	}
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		select {
+		case <-ctx.Done():
+			m.lockTwo.Lock()
+			m.conds.Two.Broadcast()
+			m.lockTwo.Unlock()
+		case <-stop:
+		}
+	}()
+	for len(m.calls.Two) < n {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		m.conds.Two.Wait()
+	}
+	return nil
+}
+
+// ResetTwo resets the calls made to Two. Stubs set with OnTwoCall for calls
+// yet to be made keep their calls, now counted from the reset.
+func (m *ExtIface) ResetTwo() {
+	m.lockTwo.Lock()
+	defer m.lockTwo.Unlock()
+
+	if n := len(m.calls.Two); n > 0 && m.onCalls.Two != nil {
+		onCalls := make(map[int]ExtIfaceTwoFunc, len(m.onCalls.Two))
+		for i, fn := range m.onCalls.Two {
+			if i >= n {
+				onCalls[i-n] = fn
+			}
+		}
+		m.onCalls.Two = onCalls
+	}
+	m.calls.Two = nil
+	m.seqs.Two = nil
+	m.unstubbed.Two = 0
+}
+
+// OnTwoCall makes the n'th call to Two, counting from 0 like TwoCalls, call fn
+// rather than TwoFunc.
+func (m *ExtIface) OnTwoCall(n int, fn ExtIfaceTwoFunc) {
+	m.lockTwo.Lock()
+	defer m.lockTwo.Unlock()
+
+	if m.onCalls.Two == nil {
+		m.onCalls.Two = make(map[int]ExtIfaceTwoFunc)
+	}
+	m.onCalls.Two[n] = fn
+}
+
+// TwoReturnsSequence queues fns for the next calls to Two to call in turn, one
+// per call, after any queued before, then falling back to TwoFunc. Calls
+// stubbed with OnTwoCall don't use up the queue.
+func (m *ExtIface) TwoReturnsSequence(fns ...ExtIfaceTwoFunc) {
+	m.lockTwo.Lock()
+	defer m.lockTwo.Unlock()
+
+	m.queued.Two = append(m.queued.Two, fns...)
+}
+
+// WithTwo sets TwoFunc to fn, taking the lock calls to Two take so it's safe
+// once the mock's shared, and returns the mock.
+func (m *ExtIface) WithTwo(fn ExtIfaceTwoFunc) *ExtIface {
+	m.lockTwo.Lock()
+	m.TwoFunc = fn
+	m.lockTwo.Unlock()
+	return m
+}
+
+// AssertTwoCallCount reports through t if Two wasn't called n times.
+func (m *ExtIface) AssertTwoCallCount(t testing.TB, n int) bool {
+	t.Helper()
+	name := m.Name
+	if name == "" {
+		name = "ExtIface"
+	}
+	if got := m.TwoCallCount(); got != n {
+		t.Errorf("%v.Two was called %v times, want %v", name, got, n)
+		return false
+	}
+	return true
+}
+
+// ExtIfaceThreeFunc is the func ExtIface.Three calls.
+type ExtIfaceThreeFunc func(arg0 av1.Int) bv1.Str
+
+// ExtIfaceThreeCall is a call made to ExtIface.Three.
+type ExtIfaceThreeCall struct {
+	Arg0 av1.Int
+
+	Ret0 bv1.Str
+
+	// Panic is the value the call panicked with, if it did.
+	Panic interface{}
+}
+
+// Three mocks base method by wrapping the associated func.
+func (m *ExtIface) Three(arg0 av1.Int) bv1.Str {
+	m.lockThree.Lock()
+	fn := m.ThreeFunc
+	if onCall, ok := m.onCalls.Three[len(m.calls.Three)]; ok {
+		fn = onCall
+		delete(m.onCalls.Three, len(m.calls.Three))
+	} else if len(m.queued.Three) > 0 {
+		fn = m.queued.Three[0]
+		m.queued.Three = m.queued.Three[1:]
+	}
+	if fn == nil {
+		if m.t == nil {
+			m.lockThree.Unlock()
+			panic("mocker: ExtIface.ThreeFunc is nil but ExtIface.Three was called.")
+		}
+		m.unstubbed.Three++
+	}
+	call := &ExtIfaceThreeCall{
+		Arg0: arg0,
+	}
+	m.calls.Three = append(m.calls.Three, call)
+	m.seqs.Three = append(m.seqs.Three, mocker.Sequence())
+	if m.conds.Three != nil {
+		m.conds.Three.Broadcast()
+	}
+	m.lockThree.Unlock()
+
+	var ret0 bv1.Str
+	defer func() {
+		r := recover()
+		m.lockThree.Lock()
+		call.Ret0 = ret0
+		call.Panic = r
+		m.lockThree.Unlock()
+		if r != nil {
+			panic(r)
+		}
+	}()
+
+	if fn == nil {
+		return ret0
+	}
+
+	ret0 = fn(arg0)
+	return ret0
+}
+
+// ThreeCalled returns true if Three was called at least once.
+func (m *ExtIface) ThreeCalled() bool {
+	m.lockThree.Lock()
+	defer m.lockThree.Unlock()
+
+	return len(m.calls.Three) > 0
+}
+
+// ThreeCalls returns the calls made to Three.
+func (m *ExtIface) ThreeCalls() []ExtIfaceThreeCall {
+	m.lockThree.Lock()
+	defer m.lockThree.Unlock()
+
+	var calls []ExtIfaceThreeCall
+	for _, call := range m.calls.Three {
+		calls = append(calls, *call)
+	}
+	return calls
+}
+
+// ThreeCallCount returns the number of calls made to Three.
+func (m *ExtIface) ThreeCallCount() int {
+	m.lockThree.Lock()
+	defer m.lockThree.Unlock()
+
+	return len(m.calls.Three)
+}
+
+// ThreeCallAt returns the i'th call made to Three, counting from 0, failing the
+// test through t if there weren't that many calls.
+func (m *ExtIface) ThreeCallAt(t testing.TB, i int) ExtIfaceThreeCall {
+	t.Helper()
+	m.lockThree.Lock()
+	defer m.lockThree.Unlock()
+
+	if i < 0 || i >= len(m.calls.Three) {
+		t.Fatalf("mocker: ExtIface.Three was called %v times, wanted call %v", len(m.calls.Three), i)
+	}
+	return *m.calls.Three[i]
+}
+
+// ThreeLastCall returns the last call made to Three, failing the test through t
+// if there weren't any.
+func (m *ExtIface) ThreeLastCall(t testing.TB) ExtIfaceThreeCall {
+	t.Helper()
+	m.lockThree.Lock()
+	defer m.lockThree.Unlock()
+
+	if len(m.calls.Three) == 0 {
+		t.Fatalf("mocker: ExtIface.Three wasn't called")
+	}
+	return *m.calls.Three[len(m.calls.Three)-1]
+}
+
+// ThreeCallsWhere returns the calls made to Three that fn returns true for.
+func (m *ExtIface) ThreeCallsWhere(fn func(ExtIfaceThreeCall) bool) []ExtIfaceThreeCall {
+	var calls []ExtIfaceThreeCall
+	for _, call := range m.ThreeCalls() {
+		if fn(call) {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// AssertThreeCalledWith reports through t unless Three was called with args
+// matching the matchers, and returns whether it was.
+func (m *ExtIface) AssertThreeCalledWith(t testing.TB, arg0 match.Matcher) bool {
+	t.Helper()
+	m.lockThree.Lock()
+	defer m.lockThree.Unlock()
+
+	for _, call := range m.calls.Three {
+		if arg0.Match(call.Arg0) {
+			return true
+		}
+	}
+	t.Errorf("mocker: ExtIface.Three wasn't called with (%v)", arg0)
+	return false
+}
+
+// ThreeCall describes the calls made to Three with args matching args, which
+// may be match.Matchers, for asserting on their order with mocker.InOrder.
+func (m *ExtIface) ThreeCall(arg0 interface{}) mocker.Call {
+	m.lockThree.Lock()
+	defer m.lockThree.Unlock()
+
+	var seqs []uint64
+	for i, call := range m.calls.Three {
+		if match.Of(arg0).Match(call.Arg0) {
+			seqs = append(seqs, m.seqs.Three[i])
+		}
+	}
+	return mocker.Call{
+		Desc: mocker.Describe("ExtIface.Three", arg0),
+		Seqs: seqs,
+	}
+}
+
+// WaitForThree blocks until Three has been called at least n times, returning
+// nil, or until ctx is done, returning its error.
+func (m *ExtIface) WaitForThree(ctx context.Context, n int) error {
+	m.lockThree.Lock()
+	defer m.lockThree.Unlock()
+
+	if m.conds.Three == nil {
+		m.conds.Three = sync.NewCond(&m.lockThree)
+	}
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		select {
+		case <-ctx.Done():
+			m.lockThree.Lock()
+			m.conds.Three.Broadcast()
+			m.lockThree.Unlock()
+		case <-stop:
+		}
+	}()
+	for len(m.calls.Three) < n {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		m.conds.Three.Wait()
+	}
+	return nil
+}
+
+// ResetThree resets the calls made to Three. Stubs set with OnThreeCall for calls
+// yet to be made keep their calls, now counted from the reset.
+func (m *ExtIface) ResetThree() {
+	m.lockThree.Lock()
+	defer m.lockThree.Unlock()
+
+	if n := len(m.calls.Three); n > 0 && m.onCalls.Three != nil {
+		onCalls := make(map[int]ExtIfaceThreeFunc, len(m.onCalls.Three))
+		for i, fn := range m.onCalls.Three {
+			if i >= n {
+				onCalls[i-n] = fn
+			}
+		}
+		m.onCalls.Three = onCalls
+	}
+	m.calls.Three = nil
+	m.seqs.Three = nil
+	m.unstubbed.Three = 0
+}
+
+// OnThreeCall makes the n'th call to Three, counting from 0 like ThreeCalls, call fn
+// rather than ThreeFunc.
+func (m *ExtIface) OnThreeCall(n int, fn ExtIfaceThreeFunc) {
+	m.lockThree.Lock()
+	defer m.lockThree.Unlock()
+
+	if m.onCalls.Three == nil {
+		m.onCalls.Three = make(map[int]ExtIfaceThreeFunc)
+	}
+	m.onCalls.Three[n] = fn
+}
+
+// ThreeReturnsSequence queues fns for the next calls to Three to call in turn, one
+// per call, after any queued before, then falling back to ThreeFunc. Calls
+// stubbed with OnThreeCall don't use up the queue.
+func (m *ExtIface) ThreeReturnsSequence(fns ...ExtIfaceThreeFunc) {
+	m.lockThree.Lock()
+	defer m.lockThree.Unlock()
+
+	m.queued.Three = append(m.queued.Three, fns...)
+}
+
+// WithThree sets ThreeFunc to fn, taking the lock calls to Three take so it's safe
+// once the mock's shared, and returns the mock.
+func (m *ExtIface) WithThree(fn ExtIfaceThreeFunc) *ExtIface {
+	m.lockThree.Lock()
+	m.ThreeFunc = fn
+	m.lockThree.Unlock()
+	return m
+}
+
+// AssertThreeCallCount reports through t if Three wasn't called n times.
+func (m *ExtIface) AssertThreeCallCount(t testing.TB, n int) bool {
+	t.Helper()
+	name := m.Name
+	if name == "" {
+		name = "ExtIface"
+	}
+	if got := m.ThreeCallCount(); got != n {
+		t.Errorf("%v.Three was called %v times, want %v", name, got, n)
+		return false
+	}
+	return true
+}
+
+// ExtIfaceFourFunc is the func ExtIface.Four calls.
+type ExtIfaceFourFunc func(arg0 c.Int)
+
+// ExtIfaceFourCall is a call made to ExtIface.Four.
+type ExtIfaceFourCall struct {
+	Arg0 c.Int
+
+	// Panic is the value the call panicked with, if it did.
+	Panic interface{}
+}
+
+// Four mocks base method by wrapping the associated func.
+func (m *ExtIface) Four(arg0 c.Int) {
+	m.lockFour.Lock()
+	fn := m.FourFunc
+	if onCall, ok := m.onCalls.Four[len(m.calls.Four)]; ok {
+		fn = onCall
+		delete(m.onCalls.Four, len(m.calls.Four))
+	} else if len(m.queued.Four) > 0 {
+		fn = m.queued.Four[0]
+		m.queued.Four = m.queued.Four[1:]
+	}
+	if fn == nil {
+		if m.t == nil {
+			m.lockFour.Unlock()
+			panic("mocker: ExtIface.FourFunc is nil but ExtIface.Four was called.")
+		}
+		m.unstubbed.Four++
+	}
+	call := &ExtIfaceFourCall{
+		Arg0: arg0,
+	}
+	m.calls.Four = append(m.calls.Four, call)
+	m.seqs.Four = append(m.seqs.Four, mocker.Sequence())
+	if m.conds.Four != nil {
+		m.conds.Four.Broadcast()
+	}
+	m.lockFour.Unlock()
+
+	defer func() {
+		r := recover()
+		m.lockFour.Lock()
+		call.Panic = r
+		m.lockFour.Unlock()
+		if r != nil {
+			panic(r)
+		}
+	}()
+
+	if fn == nil {
+		return
+	}
+
+	fn(arg0)
+}
+
+// FourCalled returns true if Four was called at least once.
+func (m *ExtIface) FourCalled() bool {
+	m.lockFour.Lock()
+	defer m.lockFour.Unlock()
+
+	return len(m.calls.Four) > 0
+}
+
+// FourCalls returns the calls made to Four.
+func (m *ExtIface) FourCalls() []ExtIfaceFourCall {
+	m.lockFour.Lock()
+	defer m.lockFour.Unlock()
+
+	var calls []ExtIfaceFourCall
+	for _, call := range m.calls.Four {
+		calls = append(calls, *call)
+	}
+	return calls
+}
+
+// FourCallCount returns the number of calls made to Four.
+func (m *ExtIface) FourCallCount() int {
+	m.lockFour.Lock()
+	defer m.lockFour.Unlock()
+
+	return len(m.calls.Four)
+}
+
+// FourCallAt returns the i'th call made to Four, counting from 0, failing the
+// test through t if there weren't that many calls.
+func (m *ExtIface) FourCallAt(t testing.TB, i int) ExtIfaceFourCall {
+	t.Helper()
+	m.lockFour.Lock()
+	defer m.lockFour.Unlock()
+
+	if i < 0 || i >= len(m.calls.Four) {
+		t.Fatalf("mocker: ExtIface.Four was called %v times, wanted call %v", len(m.calls.Four), i)
+	}
+	return *m.calls.Four[i]
+}
+
+// FourLastCall returns the last call made to Four, failing the test through t
+// if there weren't any.
+func (m *ExtIface) FourLastCall(t testing.TB) ExtIfaceFourCall {
+	t.Helper()
+	m.lockFour.Lock()
+	defer m.lockFour.Unlock()
+
+	if len(m.calls.Four) == 0 {
+		t.Fatalf("mocker: ExtIface.Four wasn't called")
+	}
+	return *m.calls.Four[len(m.calls.Four)-1]
+}
+
+// FourCallsWhere returns the calls made to Four that fn returns true for.
+func (m *ExtIface) FourCallsWhere(fn func(ExtIfaceFourCall) bool) []ExtIfaceFourCall {
+	var calls []ExtIfaceFourCall
+	for _, call := range m.FourCalls() {
+		if fn(call) {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// AssertFourCalledWith reports through t unless Four was called with args
+// matching the matchers, and returns whether it was.
+func (m *ExtIface) AssertFourCalledWith(t testing.TB, arg0 match.Matcher) bool {
+	t.Helper()
+	m.lockFour.Lock()
+	defer m.lockFour.Unlock()
+
+	for _, call := range m.calls.Four {
+		if arg0.Match(call.Arg0) {
+			return true
+		}
+	}
+	t.Errorf("mocker: ExtIface.Four wasn't called with (%v)", arg0)
+	return false
+}
+
+// FourCall describes the calls made to Four with args matching args, which
+// may be match.Matchers, for asserting on their order with mocker.InOrder.
+func (m *ExtIface) FourCall(arg0 interface{}) mocker.Call {
+	m.lockFour.Lock()
+	defer m.lockFour.Unlock()
+
+	var seqs []uint64
+	for i, call := range m.calls.Four {
+		if match.Of(arg0).Match(call.Arg0) {
+			seqs = append(seqs, m.seqs.Four[i])
+		}
+	}
+	return mocker.Call{
+		Desc: mocker.Describe("ExtIface.Four", arg0),
+		Seqs: seqs,
+	}
+}
+
+// WaitForFour blocks until Four has been called at least n times, returning
+// nil, or until ctx is done, returning its error.
+func (m *ExtIface) WaitForFour(ctx context.Context, n int) error {
+	m.lockFour.Lock()
+	defer m.lockFour.Unlock()
+
+	if m.conds.Four == nil {
+		m.conds.Four = sync.NewCond(&m.lockFour)
+	}
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		select {
+		case <-ctx.Done():
+			m.lockFour.Lock()
+			m.conds.Four.Broadcast()
+			m.lockFour.Unlock()
+		case <-stop:
+		}
+	}()
+	for len(m.calls.Four) < n {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		m.conds.Four.Wait()
+	}
+	return nil
+}
+
+// ResetFour resets the calls made to Four. Stubs set with OnFourCall for calls
+// yet to be made keep their calls, now counted from the reset.
+func (m *ExtIface) ResetFour() {
+	m.lockFour.Lock()
+	defer m.lockFour.Unlock()
+
+	if n := len(m.calls.Four); n > 0 && m.onCalls.Four != nil {
+		onCalls := make(map[int]ExtIfaceFourFunc, len(m.onCalls.Four))
+		for i, fn := range m.onCalls.Four {
+			if i >= n {
+				onCalls[i-n] = fn
+			}
+		}
+		m.onCalls.Four = onCalls
+	}
+	m.calls.Four = nil
+	m.seqs.Four = nil
+	m.unstubbed.Four = 0
+}
+
+// OnFourCall makes the n'th call to Four, counting from 0 like FourCalls, call fn
+// rather than FourFunc.
+func (m *ExtIface) OnFourCall(n int, fn ExtIfaceFourFunc) {
+	m.lockFour.Lock()
+	defer m.lockFour.Unlock()
+
+	if m.onCalls.Four == nil {
+		m.onCalls.Four = make(map[int]ExtIfaceFourFunc)
+	}
+	m.onCalls.Four[n] = fn
+}
+
+// FourReturnsSequence queues fns for the next calls to Four to call in turn, one
+// per call, after any queued before, then falling back to FourFunc. Calls
+// stubbed with OnFourCall don't use up the queue.
+func (m *ExtIface) FourReturnsSequence(fns ...ExtIfaceFourFunc) {
+	m.lockFour.Lock()
+	defer m.lockFour.Unlock()
+
+	m.queued.Four = append(m.queued.Four, fns...)
+}
+
+// WithFour sets FourFunc to fn, taking the lock calls to Four take so it's safe
+// once the mock's shared, and returns the mock.
+func (m *ExtIface) WithFour(fn ExtIfaceFourFunc) *ExtIface {
+	m.lockFour.Lock()
+	m.FourFunc = fn
+	m.lockFour.Unlock()
+	return m
+}
+
+// AssertFourCallCount reports through t if Four wasn't called n times.
+func (m *ExtIface) AssertFourCallCount(t testing.TB, n int) bool {
+	t.Helper()
+	name := m.Name
+	if name == "" {
+		name = "ExtIface"
+	}
+	if got := m.FourCallCount(); got != n {
+		t.Errorf("%v.Four was called %v times, want %v", name, got, n)
+		return false
+	}
+	return true
+}
+
+// ExtIfaceFiveFunc is the func ExtIface.Five calls.
+type ExtIfaceFiveFunc func(ctx context.Context, id string) (int, error)
+
+// ExtIfaceFiveCall is a call made to ExtIface.Five.
+type ExtIfaceFiveCall struct {
+	Ctx context.Context
+	Id  string
+
+	Ret0 int
+	Ret1 error
+
+	// Panic is the value the call panicked with, if it did.
+	Panic interface{}
+}
+
+// Five mocks base method by wrapping the associated func.
+func (m *ExtIface) Five(ctx context.Context, id string) (int, error) {
+	m.lockFive.Lock()
+	fn := m.FiveFunc
+	if onCall, ok := m.onCalls.Five[len(m.calls.Five)]; ok {
+		fn = onCall
+		delete(m.onCalls.Five, len(m.calls.Five))
+	} else if len(m.queued.Five) > 0 {
+		fn = m.queued.Five[0]
+		m.queued.Five = m.queued.Five[1:]
+	}
+	if fn == nil {
+		if m.t == nil {
+			m.lockFive.Unlock()
+			panic("mocker: ExtIface.FiveFunc is nil but ExtIface.Five was called.")
+		}
+		m.unstubbed.Five++
+	}
+	call := &ExtIfaceFiveCall{
+		Ctx: ctx,
+		Id:  id,
+	}
+	m.calls.Five = append(m.calls.Five, call)
+	m.seqs.Five = append(m.seqs.Five, mocker.Sequence())
+	if m.conds.Five != nil {
+		m.conds.Five.Broadcast()
+	}
+	m.lockFive.Unlock()
+
+	var ret0 int
+	var ret1 error
+	defer func() {
+		r := recover()
+		m.lockFive.Lock()
+		call.Ret0 = ret0
+		call.Ret1 = ret1
+		call.Panic = r
+		m.lockFive.Unlock()
+		if r != nil {
+			panic(r)
+		}
+	}()
+
+	if fn == nil {
+		return ret0, ret1
+	}
+
+	ret0, ret1 = fn(ctx, id)
+	return ret0, ret1
+}
+
+// FiveCalled returns true if Five was called at least once.
+func (m *ExtIface) FiveCalled() bool {
+	m.lockFive.Lock()
+	defer m.lockFive.Unlock()
+
+	return len(m.calls.Five) > 0
+}
+
+// FiveCalls returns the calls made to Five.
+func (m *ExtIface) FiveCalls() []ExtIfaceFiveCall {
+	m.lockFive.Lock()
+	defer m.lockFive.Unlock()
+
+	var calls []ExtIfaceFiveCall
+	for _, call := range m.calls.Five {
+		calls = append(calls, *call)
+	}
+	return calls
+}
+
+// FiveCallCount returns the number of calls made to Five.
+func (m *ExtIface) FiveCallCount() int {
+	m.lockFive.Lock()
+	defer m.lockFive.Unlock()
+
+	return len(m.calls.Five)
+}
+
+// FiveCallAt returns the i'th call made to Five, counting from 0, failing the
+// test through t if there weren't that many calls.
+func (m *ExtIface) FiveCallAt(t testing.TB, i int) ExtIfaceFiveCall {
+	t.Helper()
+	m.lockFive.Lock()
+	defer m.lockFive.Unlock()
+
+	if i < 0 || i >= len(m.calls.Five) {
+		t.Fatalf("mocker: ExtIface.Five was called %v times, wanted call %v", len(m.calls.Five), i)
+	}
+	return *m.calls.Five[i]
+}
+
+// FiveLastCall returns the last call made to Five, failing the test through t
+// if there weren't any.
+func (m *ExtIface) FiveLastCall(t testing.TB) ExtIfaceFiveCall {
+	t.Helper()
+	m.lockFive.Lock()
+	defer m.lockFive.Unlock()
+
+	if len(m.calls.Five) == 0 {
+		t.Fatalf("mocker: ExtIface.Five wasn't called")
+	}
+	return *m.calls.Five[len(m.calls.Five)-1]
+}
+
+// FiveCallsWhere returns the calls made to Five that fn returns true for.
+func (m *ExtIface) FiveCallsWhere(fn func(ExtIfaceFiveCall) bool) []ExtIfaceFiveCall {
+	var calls []ExtIfaceFiveCall
+	for _, call := range m.FiveCalls() {
+		if fn(call) {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// AssertFiveCalledWith reports through t unless Five was called with args
+// matching the matchers, and returns whether it was.
+func (m *ExtIface) AssertFiveCalledWith(t testing.TB, ctx, id match.Matcher) bool {
+	t.Helper()
+	m.lockFive.Lock()
+	defer m.lockFive.Unlock()
+
+	for _, call := range m.calls.Five {
+		if ctx.Match(call.Ctx) && id.Match(call.Id) {
+			return true
+		}
+	}
+	t.Errorf("mocker: ExtIface.Five wasn't called with (%v, %v)", ctx, id)
+	return false
+}
+
+// FiveCall describes the calls made to Five with args matching args, which
+// may be match.Matchers, for asserting on their order with mocker.InOrder.
+func (m *ExtIface) FiveCall(ctx, id interface{}) mocker.Call {
+	m.lockFive.Lock()
+	defer m.lockFive.Unlock()
+
+	var seqs []uint64
+	for i, call := range m.calls.Five {
+		if match.Of(ctx).Match(call.Ctx) && match.Of(id).Match(call.Id) {
+			seqs = append(seqs, m.seqs.Five[i])
+		}
+	}
+	return mocker.Call{
+		Desc: mocker.Describe("ExtIface.Five", ctx, id),
+		Seqs: seqs,
+	}
+}
+
+// WaitForFive blocks until Five has been called at least n times, returning
+// nil, or until ctx_2 is done, returning its error.
+func (m *ExtIface) WaitForFive(ctx_2 context.Context, n int) error {
+	m.lockFive.Lock()
+	defer m.lockFive.Unlock()
+
+	if m.conds.Five == nil {
+		m.conds.Five = sync.NewCond(&m.lockFive)
+	}
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		select {
+		case <-ctx_2.Done():
+			m.lockFive.Lock()
+			m.conds.Five.Broadcast()
+			m.lockFive.Unlock()
+		case <-stop:
+		}
+	}()
+	for len(m.calls.Five) < n {
+		if err := ctx_2.Err(); err != nil {
+			return err
+		}
+		m.conds.Five.Wait()
+	}
+	return nil
+}
+
+// ResetFive resets the calls made to Five. Stubs set with OnFiveCall for calls
+// yet to be made keep their calls, now counted from the reset.
+func (m *ExtIface) ResetFive() {
+	m.lockFive.Lock()
+	defer m.lockFive.Unlock()
+
+	if n := len(m.calls.Five); n > 0 && m.onCalls.Five != nil {
+		onCalls := make(map[int]ExtIfaceFiveFunc, len(m.onCalls.Five))
+		for i, fn := range m.onCalls.Five {
+			if i >= n {
+				onCalls[i-n] = fn
+			}
+		}
+		m.onCalls.Five = onCalls
+	}
+	m.calls.Five = nil
+	m.seqs.Five = nil
+	m.unstubbed.Five = 0
+}
+
+// OnFiveCall makes the n'th call to Five, counting from 0 like FiveCalls, call fn
+// rather than FiveFunc.
+func (m *ExtIface) OnFiveCall(n int, fn ExtIfaceFiveFunc) {
+	m.lockFive.Lock()
+	defer m.lockFive.Unlock()
+
+	if m.onCalls.Five == nil {
+		m.onCalls.Five = make(map[int]ExtIfaceFiveFunc)
+	}
+	m.onCalls.Five[n] = fn
+}
+
+// FiveReturnsSequence queues fns for the next calls to Five to call in turn, one
+// per call, after any queued before, then falling back to FiveFunc. Calls
+// stubbed with OnFiveCall don't use up the queue.
+func (m *ExtIface) FiveReturnsSequence(fns ...ExtIfaceFiveFunc) {
+	m.lockFive.Lock()
+	defer m.lockFive.Unlock()
+
+	m.queued.Five = append(m.queued.Five, fns...)
+}
+
+// WithFive sets FiveFunc to fn, taking the lock calls to Five take so it's safe
+// once the mock's shared, and returns the mock.
+func (m *ExtIface) WithFive(fn ExtIfaceFiveFunc) *ExtIface {
+	m.lockFive.Lock()
+	m.FiveFunc = fn
+	m.lockFive.Unlock()
+	return m
+}
+
+// AssertFiveCallCount reports through t if Five wasn't called n times.
+func (m *ExtIface) AssertFiveCallCount(t testing.TB, n int) bool {
+	t.Helper()
+	name := m.Name
+	if name == "" {
+		name = "ExtIface"
+	}
+	if got := m.FiveCallCount(); got != n {
+		t.Errorf("%v.Five was called %v times, want %v", name, got, n)
+		return false
+	}
+	return true
+}
+
+// Reset resets the calls made to the mocked methods.
+func (m *ExtIface) Reset() {
+	m.ResetOne()
+	m.ResetTwo()
+	m.ResetThree()
+	m.ResetFour()
+	m.ResetFive()
+}
+
+// Fresh resets the calls made to the mock and returns it.
+func (m *ExtIface) Fresh() *ExtIface {
+	m.Reset()
+	return m
+}
+
+// ResetStubs clears the funcs and per-call stubs of the mocked methods.
+func (m *ExtIface) ResetStubs() {
+	m.lockOne.Lock()
+	m.OneFunc = nil
+	m.onCalls.One = nil
+	m.queued.One = nil
+	m.lockOne.Unlock()
+	m.lockTwo.Lock()
+	m.TwoFunc = nil
+	m.onCalls.Two = nil
+	m.queued.Two = nil
+	m.lockTwo.Unlock()
+	m.lockThree.Lock()
+	m.ThreeFunc = nil
+	m.onCalls.Three = nil
+	m.queued.Three = nil
+	m.lockThree.Unlock()
+	m.lockFour.Lock()
+	m.FourFunc = nil
+	m.onCalls.Four = nil
+	m.queued.Four = nil
+	m.lockFour.Unlock()
+	m.lockFive.Lock()
+	m.FiveFunc = nil
+	m.onCalls.Five = nil
+	m.queued.Five = nil
+	m.lockFive.Unlock()
+}
+
+// ResetAll resets the calls made to the mocked methods and clears their
+// funcs and per-call stubs.
+func (m *ExtIface) ResetAll() {
+	m.Reset()
+	m.ResetStubs()
+}
+
+// Verify reports through t any calls made to methods without a func, funcs
+// and per-call stubs that weren't called, and unmet expectations. It returns
+// whether there were none.
+func (m *ExtIface) Verify(t testing.TB) bool {
+	t.Helper()
+	ok := true
+	m.lockOne.Lock()
+	if m.unstubbed.One > 0 {
+		t.Errorf("mocker: ExtIface.One was called %v times without a func", m.unstubbed.One)
+		ok = false
+	}
+	if m.OneFunc != nil && len(m.calls.One) == 0 {
+		t.Errorf("mocker: ExtIface.OneFunc is set but One wasn't called")
+		ok = false
+	}
+	for n := range m.onCalls.One {
+		t.Errorf("mocker: ExtIface.One's stub for call %v wasn't called", n)
+		ok = false
+	}
+	if len(m.queued.One) > 0 {
+		t.Errorf("mocker: ExtIface.One's last %v stubs in sequence weren't called", len(m.queued.One))
+		ok = false
+	}
+	m.lockOne.Unlock()
+	m.lockTwo.Lock()
+	if m.unstubbed.Two > 0 {
+		t.Errorf("mocker: ExtIface.Two was called %v times without a func", m.unstubbed.Two)
+		ok = false
+	}
+	if m.TwoFunc != nil && len(m.calls.Two) == 0 {
+		t.Errorf("mocker: ExtIface.TwoFunc is set but Two wasn't called")
+		ok = false
+	}
+	for n := range m.onCalls.Two {
+		t.Errorf("mocker: ExtIface.Two's stub for call %v wasn't called", n)
+		ok = false
+	}
+	if len(m.queued.Two) > 0 {
+		t.Errorf("mocker: ExtIface.Two's last %v stubs in sequence weren't called", len(m.queued.Two))
+		ok = false
+	}
+	m.lockTwo.Unlock()
+	m.lockThree.Lock()
+	if m.unstubbed.Three > 0 {
+		t.Errorf("mocker: ExtIface.Three was called %v times without a func", m.unstubbed.Three)
+		ok = false
+	}
+	if m.ThreeFunc != nil && len(m.calls.Three) == 0 {
+		t.Errorf("mocker: ExtIface.ThreeFunc is set but Three wasn't called")
+		ok = false
+	}
+	for n := range m.onCalls.Three {
+		t.Errorf("mocker: ExtIface.Three's stub for call %v wasn't called", n)
+		ok = false
+	}
+	if len(m.queued.Three) > 0 {
+		t.Errorf("mocker: ExtIface.Three's last %v stubs in sequence weren't called", len(m.queued.Three))
+		ok = false
+	}
+	m.lockThree.Unlock()
+	m.lockFour.Lock()
+	if m.unstubbed.Four > 0 {
+		t.Errorf("mocker: ExtIface.Four was called %v times without a func", m.unstubbed.Four)
+		ok = false
+	}
+	if m.FourFunc != nil && len(m.calls.Four) == 0 {
+		t.Errorf("mocker: ExtIface.FourFunc is set but Four wasn't called")
+		ok = false
+	}
+	for n := range m.onCalls.Four {
+		t.Errorf("mocker: ExtIface.Four's stub for call %v wasn't called", n)
+		ok = false
+	}
+	if len(m.queued.Four) > 0 {
+		t.Errorf("mocker: ExtIface.Four's last %v stubs in sequence weren't called", len(m.queued.Four))
+		ok = false
+	}
+	m.lockFour.Unlock()
+	m.lockFive.Lock()
+	if m.unstubbed.Five > 0 {
+		t.Errorf("mocker: ExtIface.Five was called %v times without a func", m.unstubbed.Five)
+		ok = false
+	}
+	if m.FiveFunc != nil && len(m.calls.Five) == 0 {
+		t.Errorf("mocker: ExtIface.FiveFunc is set but Five wasn't called")
+		ok = false
+	}
+	for n := range m.onCalls.Five {
+		t.Errorf("mocker: ExtIface.Five's stub for call %v wasn't called", n)
+		ok = false
+	}
+	if len(m.queued.Five) > 0 {
+		t.Errorf("mocker: ExtIface.Five's last %v stubs in sequence weren't called", len(m.queued.Five))
+		ok = false
+	}
+	m.lockFive.Unlock()
+	return ok
+}
+
+// ExtMocks are the mocks generated in this file.
+var ExtMocks = []interface{}{(*ExtIface)(nil)}
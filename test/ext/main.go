@@ -0,0 +1,82 @@
+// Command ext is a custom mocker binary generating test/ext.go with an
+// extension naming mocks in their assertions' failures.
+package main
+
+import (
+	"log"
+	"strings"
+
+	"github.com/golang/mock/mockgen/model"
+	"github.com/travisjeffery/mocker/pkg/mocker"
+)
+
+func main() {
+	c := mocker.Config{
+		Src: "test/in.go",
+		Dst: "test/ext.go",
+		Pre: "Ext",
+		Itf: []string{"Iface"},
+		Ext: []mocker.Extension{named()},
+	}
+	if err := mocker.Run(c); err != nil {
+		log.Fatalf("ext: failed to mock: %v", err)
+	}
+}
+
+// named returns an extension giving mocks a Name their call count assertions
+// report failures with.
+func named() mocker.Extension {
+	var mocks []string
+	return mocker.Extension{
+		Fields: func(g *mocker.Generator, mock string, intf *model.Interface) error {
+			mocks = append(mocks, mock)
+			g.P("")
+			g.P("// Name names the mock in failures, rather than its type.")
+			g.P("Name string")
+			return nil
+		},
+		Method: func(g *mocker.Generator, mock string, m *model.Method) error {
+			g.P("// Assert%vCallCount reports through t if %v wasn't called n times.", m.Name, m.Name)
+			g.P("func (m *%v) Assert%vCallCount(t %v, n int) bool {", mock, m.Name, g.Qualify("testing", "TB"))
+			g.In()
+			g.P("t.Helper()")
+			g.P("name := m.Name")
+			g.P("if name == \"\" {")
+			g.In()
+			g.P("name = %q", mock)
+			g.Out()
+			g.P("}")
+			g.P("if got := m.%vCallCount(); got != n {", m.Name)
+			g.In()
+			g.P("t.Errorf(\"%%v.%v was called %%v times, want %%v\", name, got, n)", m.Name)
+			g.P("return false")
+			g.Out()
+			g.P("}")
+			g.P("return true")
+			g.Out()
+			g.P("}")
+			return nil
+		},
+		Reset: func(g *mocker.Generator, mock string, intf *model.Interface) error {
+			g.P("// Fresh resets the calls made to the mock and returns it.")
+			g.P("func (m *%v) Fresh() *%v {", mock, mock)
+			g.In()
+			g.P("m.Reset()")
+			g.P("return m")
+			g.Out()
+			g.P("}")
+			return nil
+		},
+		Decls: func(g *mocker.Generator) error {
+			ptrs := make([]string, len(mocks))
+			for i, mock := range mocks {
+				ptrs[i] = "(*" + mock + ")(nil)"
+			}
+			mocks = nil
+			name := g.Ident("ExtMocks")
+			g.P("// %v are the mocks generated in this file.", name)
+			g.P("var %v = []interface{}{%v}", name, strings.Join(ptrs, ", "))
+			return nil
+		},
+	}
+}
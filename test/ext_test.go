@@ -0,0 +1,32 @@
+package test
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestExtIface(t *testing.T) {
+	m := &ExtIface{
+		Name: "iface",
+		TwoFunc: func(x, y int) int {
+			return x + y
+		},
+	}
+	m.Two(1, 2)
+
+	ft := &fakeT{}
+	if m.AssertTwoCallCount(ft, 2) {
+		t.Error("assert two call count = true, want false")
+	}
+	want := []string{"iface.Two was called 1 times, want 2"}
+	if !reflect.DeepEqual(ft.errors, want) {
+		t.Errorf("errors = %v, want %v", ft.errors, want)
+	}
+
+	if !m.Fresh().AssertTwoCallCount(t, 0) {
+		t.Error("assert two call count after fresh = false, want true")
+	}
+	if len(ExtMocks) != 1 {
+		t.Errorf("ext mocks = %v, want 1", len(ExtMocks))
+	}
+}
@@ -0,0 +1,105 @@
+// Code generated by mocker. DO NOT EDIT.
+// github.com/travisjeffery/mocker
+// Source: test/in.go
+
+package test
+
+import (
+	"context"
+	"sync"
+
+	av1 "github.com/travisjeffery/mocker/test/a"
+	bv1 "github.com/travisjeffery/mocker/test/b"
+	"github.com/travisjeffery/mocker/test/c"
+)
+
+// FakeIface is a fake of Iface calling its funcs.
+type FakeIface struct {
+	mu    sync.Mutex
+	calls int
+
+	OneFunc   func(string, ...string) (string, []string)
+	TwoFunc   func(int, int) int
+	ThreeFunc func(av1.Int) bv1.Str
+	FourFunc  func(c.Int)
+	FiveFunc  func(context.Context, string) (int, error)
+}
+
+// Calls returns how many calls were made to the fake.
+func (f *FakeIface) Calls() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.calls
+}
+
+// One calls OneFunc.
+func (f *FakeIface) One(str string, variadic ...string) (string, []string) {
+	f.mu.Lock()
+	f.calls++
+	f.mu.Unlock()
+	return f.OneFunc(str, variadic...)
+}
+
+// Two calls TwoFunc.
+func (f *FakeIface) Two(arg0 int, arg1 int) int {
+	f.mu.Lock()
+	f.calls++
+	f.mu.Unlock()
+	return f.TwoFunc(arg0, arg1)
+}
+
+// Three calls ThreeFunc.
+func (f *FakeIface) Three(arg0 av1.Int) bv1.Str {
+	f.mu.Lock()
+	f.calls++
+	f.mu.Unlock()
+	return f.ThreeFunc(arg0)
+}
+
+// Four calls FourFunc.
+func (f *FakeIface) Four(arg0 c.Int) {
+	f.mu.Lock()
+	f.calls++
+	f.mu.Unlock()
+	f.FourFunc(arg0)
+}
+
+// Five calls FiveFunc.
+func (f *FakeIface) Five(ctx context.Context, id string) (int, error) {
+	f.mu.Lock()
+	f.calls++
+	f.mu.Unlock()
+	return f.FiveFunc(ctx, id)
+}
+
+// FakeShadow is a fake of Shadow calling its funcs.
+type FakeShadow struct {
+	mu    sync.Mutex
+	calls int
+
+	FiveFunc func(int, string, bool, c.Int, int, int)
+	SixFunc  func(func(...int) int, ...func(...string))
+}
+
+// Calls returns how many calls were made to the fake.
+func (f *FakeShadow) Calls() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.calls
+}
+
+// Five calls FiveFunc.
+func (f *FakeShadow) Five(m int, call string, sync_2 bool, c_2 c.Int, arg4 int, arg4_2 int) {
+	f.mu.Lock()
+	f.calls++
+	f.mu.Unlock()
+	f.FiveFunc(m, call, sync_2, c_2, arg4, arg4_2)
+}
+
+// Six calls SixFunc.
+func (f *FakeShadow) Six(cb func(...int) int, opts ...func(...string)) {
+	f.mu.Lock()
+	f.calls++
+	f.mu.Unlock()
+	f.SixFunc(cb, opts...)
+}
@@ -0,0 +1,31 @@
+package test
+
+import (
+	"context"
+	"testing"
+)
+
+var (
+	_ Iface  = (*FakeIface)(nil)
+	_ Shadow = (*FakeShadow)(nil)
+)
+
+func TestFakeIface(t *testing.T) {
+	f := &FakeIface{
+		FiveFunc: func(ctx context.Context, id string) (int, error) {
+			return len(id), nil
+		},
+		OneFunc: func(s string, variadic ...string) (string, []string) {
+			return s, variadic
+		},
+	}
+	if n, err := f.Five(context.Background(), "travis"); n != 6 || err != nil {
+		t.Errorf("five = %v, %v, want %v, %v", n, err, 6, nil)
+	}
+	if _, got := f.One("a", "b", "c"); len(got) != 2 {
+		t.Errorf("one variadic = %v, want %v", got, []string{"b", "c"})
+	}
+	if got := f.Calls(); got != 2 {
+		t.Errorf("calls = %v, want %v", got, 2)
+	}
+}
@@ -1,14 +1,21 @@
 package test
 
 import (
+	"context"
 	"fmt"
 	"reflect"
+	"runtime"
 	"strconv"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/travisjeffery/mocker"
 	av1 "github.com/travisjeffery/mocker/test/a"
 	bv1 "github.com/travisjeffery/mocker/test/b"
 	"github.com/travisjeffery/mocker/test/c"
+	d "github.com/travisjeffery/mocker/test/d"
 )
 
 func TestIface(t *testing.T) {
@@ -57,10 +64,19 @@ func TestIface(t *testing.T) {
 	if !iface.OneCalled() {
 		t.Errorf("OneCalled() = %v, want %v", iface.OneCalled(), true)
 	}
+	for i, call := range iface.OneCalls() {
+		if call.Ret0 != ones[i].str || !reflect.DeepEqual(call.Ret1, ones[i].variadic) {
+			t.Errorf("OneCalls()[%d] results = %v, %v, want %v, %v", i, call.Ret0, call.Ret1, ones[i].str, ones[i].variadic)
+		}
+	}
 	z := iface.Two(1, 2)
 	if z != 3 {
 		t.Errorf("z = %v, want %v", z, 3)
 	}
+	wantTwo := []MockIfaceTwoCall{{Arg0: 1, Arg1: 2, Ret0: 3}}
+	if !reflect.DeepEqual(iface.TwoCalls(), wantTwo) {
+		t.Errorf("TwoCalls() = %v, want %v", iface.TwoCalls(), wantTwo)
+	}
 	iface.Reset()
 	if len(iface.OneCalls()) != 0 {
 		t.Errorf("onecalls = %v, want %v", len(iface.OneCalls()), 0)
@@ -75,3 +91,429 @@ func TestIface(t *testing.T) {
 		t.Errorf("Three(): got = %v, want = %v", iface.Three(av1.Int(1)), "1")
 	}
 }
+
+func TestShadow(t *testing.T) {
+	var got c.Int
+	shadow := &MockShadow{
+		FiveFunc: func(m int, call string, sync bool, c c.Int, _ int, arg4 int) {
+			got = c
+		},
+	}
+	shadow.Five(1, "call", true, c.Int(2), 3, 4)
+	if got != c.Int(2) {
+		t.Errorf("c = %v, want %v", got, 2)
+	}
+	calls := shadow.FiveCalls()
+	if len(calls) != 1 {
+		t.Fatalf("fivecalls = %v, want %v", len(calls), 1)
+	}
+	if calls[0].M != 1 || calls[0].Call != "call" || !calls[0].Sync || calls[0].C != c.Int(2) || calls[0].Arg4 != 3 || calls[0].Arg4_2 != 4 {
+		t.Errorf("FiveCalls()[0] = %+v", calls[0])
+	}
+}
+
+func TestAlias(t *testing.T) {
+	alias := &MockAlias{
+		GetFunc: func(n d.Int) d.Int {
+			return n + 1
+		},
+	}
+	if got := alias.Get(d.Int(1)); got != d.Int(2) {
+		t.Errorf("Get() = %v, want %v", got, 2)
+	}
+}
+
+func TestEdge(t *testing.T) {
+	edge := NewMockEdge(t)
+	edge.NextFunc = func() Edge {
+		return edge
+	}
+	if got := edge.Next(); got != Edge(edge) {
+		t.Errorf("Next() = %v, want %v", got, edge)
+	}
+}
+
+func TestShadowNestedVariadic(t *testing.T) {
+	shadow := &MockShadow{
+		SixFunc: func(cb func(...int) int, opts ...func(...string)) {
+			cb(1, 2)
+		},
+	}
+	var sum int
+	cb := func(xs ...int) int {
+		for _, x := range xs {
+			sum += x
+		}
+		return sum
+	}
+	shadow.Six(cb, func(...string) {}, func(...string) {})
+	if sum != 3 {
+		t.Errorf("sum = %v, want %v", sum, 3)
+	}
+	calls := shadow.SixCalls()
+	if len(calls) != 1 {
+		t.Fatalf("sixcalls = %v, want %v", len(calls), 1)
+	}
+	if got := calls[0].Cb(4); got != 7 {
+		t.Errorf("SixCalls()[0].Cb(4) = %v, want %v", got, 7)
+	}
+	if len(calls[0].Opts) != 2 {
+		t.Errorf("len(SixCalls()[0].Opts) = %v, want %v", len(calls[0].Opts), 2)
+	}
+}
+
+func TestIfacePanic(t *testing.T) {
+	iface := &MockIface{
+		TwoFunc: func(x, y int) int {
+			panic("two")
+		},
+	}
+	func() {
+		defer func() {
+			if r := recover(); r != "two" {
+				t.Errorf("recover() = %v, want %v", r, "two")
+			}
+		}()
+		iface.Two(1, 2)
+	}()
+	want := []MockIfaceTwoCall{{Arg0: 1, Arg1: 2, Panic: "two"}}
+	if !reflect.DeepEqual(iface.TwoCalls(), want) {
+		t.Errorf("TwoCalls() = %v, want %v", iface.TwoCalls(), want)
+	}
+}
+
+func TestTraceIface(t *testing.T) {
+	iface := &TraceIface{
+		FourFunc: func(x c.Int) {
+		},
+	}
+	_, file, line, _ := runtime.Caller(0)
+	iface.Four(c.Int(1))
+	done := make(chan struct{})
+	go func() {
+		iface.Four(c.Int(2))
+		close(done)
+	}()
+	<-done
+	calls := iface.FourCalls()
+	if len(calls) != 2 {
+		t.Fatalf("fourcalls = %v, want %v", len(calls), 2)
+	}
+	if want := file + ":" + strconv.Itoa(line+1); calls[0].Caller != want {
+		t.Errorf("Caller = %v, want %v", calls[0].Caller, want)
+	}
+	if !strings.HasPrefix(calls[1].Caller, file+":") {
+		t.Errorf("Caller = %v, want prefix %v", calls[1].Caller, file)
+	}
+	if calls[0].Time.IsZero() || calls[1].Time.Before(calls[0].Time) {
+		t.Errorf("Time = %v, %v, want increasing times", calls[0].Time, calls[1].Time)
+	}
+	if calls[0].Goroutine == 0 || calls[0].Goroutine == calls[1].Goroutine {
+		t.Errorf("Goroutine = %v, %v, want distinct goroutines", calls[0].Goroutine, calls[1].Goroutine)
+	}
+}
+
+func TestIfaceReentrant(t *testing.T) {
+	iface := &MockIface{}
+	iface.TwoFunc = func(x, y int) int {
+		if x == 0 {
+			return y
+		}
+		return iface.Two(x-1, y+1)
+	}
+	if z := iface.Two(3, 0); z != 3 {
+		t.Errorf("z = %v, want %v", z, 3)
+	}
+	if len(iface.TwoCalls()) != 4 {
+		t.Errorf("twocalls = %v, want %v", len(iface.TwoCalls()), 4)
+	}
+}
+
+func TestIfaceWith(t *testing.T) {
+	iface := NewMockIface(t).
+		WithTwo(func(x, y int) int { return x + y }).
+		WithFour(func(c.Int) {})
+
+	// Swapping stubs while the mock's called is race free.
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			iface.Two(1, 2)
+		}
+	}()
+	iface.WithTwo(func(x, y int) int { return x * y })
+	wg.Wait()
+
+	if got := iface.Two(2, 3); got != 6 {
+		t.Errorf("two = %v, want %v", got, 6)
+	}
+	iface.Four(1)
+}
+
+func TestIfaceConcurrent(t *testing.T) {
+	// Each call waits for the other to start, so the test deadlocks if the
+	// mock serializes them.
+	var started sync.WaitGroup
+	started.Add(2)
+	iface := &MockIface{
+		TwoFunc: func(x, y int) int {
+			started.Done()
+			started.Wait()
+			return x + y
+		},
+	}
+	var wg sync.WaitGroup
+	for i := 0; i < 2; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			iface.Two(i, i)
+		}(i)
+	}
+	wg.Wait()
+	if len(iface.TwoCalls()) != 2 {
+		t.Errorf("twocalls = %v, want %v", len(iface.TwoCalls()), 2)
+	}
+}
+
+func TestLooseIface(t *testing.T) {
+	iface := &LooseIface{}
+	if n, err := iface.Five(context.Background(), "id"); n != 0 || err != nil {
+		t.Errorf("Five() = %v, %v, want %v, %v", n, err, 0, nil)
+	}
+	iface.NotStubbedErr = mocker.ErrNotStubbed
+	if _, err := iface.Five(context.Background(), "id"); err != mocker.ErrNotStubbed {
+		t.Errorf("Five() err = %v, want %v", err, mocker.ErrNotStubbed)
+	}
+	calls := iface.FiveCalls()
+	if len(calls) != 2 {
+		t.Fatalf("fivecalls = %v, want %v", len(calls), 2)
+	}
+	if calls[1].Id != "id" || calls[1].Ret1 != mocker.ErrNotStubbed {
+		t.Errorf("FiveCalls()[1] = %+v", calls[1])
+	}
+	iface.Four(c.Int(1))
+	if !iface.FourCalled() {
+		t.Errorf("FourCalled() = %v, want %v", iface.FourCalled(), true)
+	}
+
+	iface.Strict = true
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("recover() = %v, want a panic", r)
+		}
+	}()
+	iface.Four(c.Int(2))
+}
+
+func TestSpyIface(t *testing.T) {
+	iface := &SpyIface{
+		Delegate: &MockIface{
+			TwoFunc: func(x, y int) int {
+				return x + y
+			},
+		},
+		FourFunc: func(x c.Int) {
+		},
+	}
+	if z := iface.Two(1, 2); z != 3 {
+		t.Errorf("z = %v, want %v", z, 3)
+	}
+	want := []SpyIfaceTwoCall{{Arg0: 1, Arg1: 2, Ret0: 3}}
+	if !reflect.DeepEqual(iface.TwoCalls(), want) {
+		t.Errorf("TwoCalls() = %v, want %v", iface.TwoCalls(), want)
+	}
+	iface.Four(c.Int(1))
+	if iface.Delegate.(*MockIface).FourCalled() {
+		t.Errorf("Delegate.FourCalled() = %v, want %v", true, false)
+	}
+}
+
+func TestIfaceSequence(t *testing.T) {
+	iface := &MockIface{
+		FiveFunc: func(ctx context.Context, id string) (int, error) {
+			return 1, nil
+		},
+	}
+	fail := func(ctx context.Context, id string) (int, error) {
+		return 0, fmt.Errorf("failed")
+	}
+	iface.FiveReturnsSequence(fail, fail)
+	iface.OnFiveCall(3, func(ctx context.Context, id string) (int, error) {
+		return 3, nil
+	})
+	want := []int{0, 0, 1, 3, 1}
+	for i, w := range want {
+		n, err := iface.Five(context.Background(), "id")
+		if n != w {
+			t.Errorf("call %d: n = %v, want %v", i, n, w)
+		}
+		if (err != nil) != (i < 2) {
+			t.Errorf("call %d: err = %v", i, err)
+		}
+	}
+}
+
+func TestIfaceSequenceQueues(t *testing.T) {
+	iface := &MockIface{
+		TwoFunc: func(x, y int) int { return 0 },
+	}
+	ret := func(n int) MockIfaceTwoFunc {
+		return func(x, y int) int { return n }
+	}
+	iface.TwoReturnsSequence(ret(1), ret(2))
+	iface.Two(0, 0)
+	// Queued behind the first sequence's remaining stub, not over it.
+	iface.TwoReturnsSequence(ret(3))
+	iface.OnTwoCall(2, ret(4))
+	want := []int{2, 4, 3, 0}
+	for i, w := range want {
+		if got := iface.Two(0, 0); got != w {
+			t.Errorf("call %d: two = %v, want %v", i+1, got, w)
+		}
+	}
+}
+
+func TestIfaceResetRebasesOnCalls(t *testing.T) {
+	iface := &MockIface{
+		TwoFunc: func(x, y int) int { return 0 },
+	}
+	iface.OnTwoCall(3, func(x, y int) int { return 3 })
+	iface.Two(0, 0)
+	iface.Two(0, 0)
+	iface.ResetTwo()
+	// The stub's still for the 4th call made, now the 2nd since the reset.
+	want := []int{0, 3, 0}
+	for i, w := range want {
+		if got := iface.Two(0, 0); got != w {
+			t.Errorf("call %d: two = %v, want %v", i, got, w)
+		}
+	}
+
+	iface.TwoReturnsSequence(func(x, y int) int { return 1 })
+	iface.ResetStubs()
+	iface.TwoFunc = func(x, y int) int { return 0 }
+	if got := iface.Two(0, 0); got != 0 {
+		t.Errorf("two after reset stubs = %v, want %v", got, 0)
+	}
+}
+
+func TestIfaceQueries(t *testing.T) {
+	iface := &MockIface{
+		TwoFunc: func(x, y int) int {
+			return x + y
+		},
+	}
+	for i := 0; i < 3; i++ {
+		iface.Two(i, 1)
+	}
+	if n := iface.TwoCallCount(); n != 3 {
+		t.Errorf("TwoCallCount() = %v, want %v", n, 3)
+	}
+	if call := iface.TwoCallAt(t, 1); call.Arg0 != 1 || call.Ret0 != 2 {
+		t.Errorf("TwoCallAt(1) = %+v", call)
+	}
+	if call := iface.TwoLastCall(t); call.Arg0 != 2 || call.Ret0 != 3 {
+		t.Errorf("TwoLastCall() = %+v", call)
+	}
+	calls := iface.TwoCallsWhere(func(call MockIfaceTwoCall) bool {
+		return call.Ret0 > 1
+	})
+	want := []MockIfaceTwoCall{{Arg0: 1, Arg1: 1, Ret0: 2}, {Arg0: 2, Arg1: 1, Ret0: 3}}
+	if !reflect.DeepEqual(calls, want) {
+		t.Errorf("TwoCallsWhere() = %v, want %v", calls, want)
+	}
+
+	for _, test := range []struct {
+		name string
+		fn   func(t testing.TB)
+		want string
+	}{
+		{"call at", func(t testing.TB) { iface.TwoCallAt(t, 3) }, "mocker: MockIface.Two was called 3 times, wanted call 3"},
+		{"last call", func(t testing.TB) { iface.OneLastCall(t) }, "mocker: MockIface.One wasn't called"},
+	} {
+		ft := &fakeT{}
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			test.fn(ft)
+		}()
+		<-done
+		if want := []string{test.want}; !reflect.DeepEqual(ft.errors, want) {
+			t.Errorf("%v: errors = %v, want %v", test.name, ft.errors, want)
+		}
+	}
+}
+
+func TestIfaceResets(t *testing.T) {
+	two := func(x, y int) int {
+		return x + y
+	}
+	iface := &MockIface{
+		TwoFunc: two,
+		FourFunc: func(x c.Int) {
+		},
+	}
+	iface.Two(1, 2)
+	iface.Four(c.Int(1))
+	iface.ResetTwo()
+	if iface.TwoCalled() {
+		t.Errorf("TwoCalled() = %v, want %v", true, false)
+	}
+	if !iface.FourCalled() {
+		t.Errorf("FourCalled() = %v, want %v", false, true)
+	}
+
+	iface.OnTwoCall(0, two)
+	iface.ResetStubs()
+	if iface.TwoFunc != nil || iface.FourFunc != nil {
+		t.Errorf("funcs set after ResetStubs()")
+	}
+	if !iface.FourCalled() {
+		t.Errorf("FourCalled() = %v, want %v", false, true)
+	}
+	func() {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Errorf("recover() = %v, want a panic", r)
+			}
+		}()
+		iface.Two(1, 2)
+	}()
+
+	iface.TwoFunc = two
+	iface.Two(1, 2)
+	iface.ResetAll()
+	if iface.TwoFunc != nil || iface.TwoCalled() || iface.FourCalled() {
+		t.Errorf("calls or funcs left after ResetAll()")
+	}
+}
+
+func TestIfaceWaitFor(t *testing.T) {
+	iface := &MockIface{
+		TwoFunc: func(x, y int) int {
+			return x + y
+		},
+	}
+	go func() {
+		for i := 0; i < 2; i++ {
+			iface.Two(i, i)
+		}
+	}()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := iface.WaitForTwo(ctx, 2); err != nil {
+		t.Fatalf("WaitForTwo() = %v, want %v", err, nil)
+	}
+	if n := iface.TwoCallCount(); n != 2 {
+		t.Errorf("TwoCallCount() = %v, want %v", n, 2)
+	}
+
+	ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := iface.WaitForTwo(ctx, 3); err != context.DeadlineExceeded {
+		t.Errorf("WaitForTwo() = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
@@ -1,9 +1,12 @@
 package test
 
 import (
+	"context"
+
 	av1 "github.com/travisjeffery/mocker/test/a"
 	bv1 "github.com/travisjeffery/mocker/test/b"
 	"github.com/travisjeffery/mocker/test/c"
+	d "github.com/travisjeffery/mocker/test/d"
 )
 
 type Iface interface {
@@ -11,4 +14,40 @@ type Iface interface {
 	Two(int, int) int
 	Three(av1.Int) bv1.Str
 	Four(c.Int)
+	Five(ctx context.Context, id string) (int, error)
+}
+
+// Shadow has parameters named after the mock's imports and locals, and
+// variadics nested in func types.
+type Shadow interface {
+	Five(m int, call string, sync bool, c c.Int, _ int, arg4 int)
+	Six(cb func(...int) int, opts ...func(...string))
+}
+
+// Alias uses a package imported under a name other than its own.
+type Alias interface {
+	Get(n d.Int) d.Int
+}
+
+// Edge has an unexported method, so mocks of it outside this package can't
+// implement it.
+type Edge interface {
+	Next() Edge
+	unexported()
+}
+
+// Clash has methods named like the helpers the mock adds.
+type Clash interface {
+	Verify(token string) error
+	Close() error
+	Keys() []string
+	Do()
+	DoCall(id string)
+	All() []string
+	Stubs()
+	Foo(n int)
+	ResetFoo()
+	Wait(ctx context.Context, n int)
+	WaitForWait()
+	Subscribe(topic string)
 }
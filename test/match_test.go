@@ -0,0 +1,98 @@
+package test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/travisjeffery/mocker/pkg/mocker/match"
+	"github.com/travisjeffery/mocker/test/c"
+)
+
+func TestAssertCalledWith(t *testing.T) {
+	iface := &MockIface{
+		OneFunc: func(str string, variadic ...string) (string, []string) {
+			return str, variadic
+		},
+		FourFunc: func(x c.Int) {
+		},
+		FiveFunc: func(ctx context.Context, id string) (int, error) {
+			return 0, nil
+		},
+	}
+	iface.One("first", "a", "b")
+	iface.Four(c.Int(4))
+	iface.Five(context.Background(), "user-1")
+
+	tests := []struct {
+		name string
+		ok   bool
+		fn   func(t testing.TB) bool
+	}{
+		{"any", true, func(t testing.TB) bool {
+			return iface.AssertOneCalledWith(t, match.Any(), match.Any())
+		}},
+		{"eq", true, func(t testing.TB) bool {
+			return iface.AssertOneCalledWith(t, match.Eq("first"), match.Eq([]string{"a", "b"}))
+		}},
+		{"eq untyped constant", true, func(t testing.TB) bool {
+			return iface.AssertFourCalledWith(t, match.Eq(4))
+		}},
+		{"eq mismatch", false, func(t testing.TB) bool {
+			return iface.AssertOneCalledWith(t, match.Eq("second"), match.Any())
+		}},
+		{"regexp", true, func(t testing.TB) bool {
+			return iface.AssertFiveCalledWith(t, match.Context(), match.Regexp(`^user-\d+$`))
+		}},
+		{"regexp mismatch", false, func(t testing.TB) bool {
+			return iface.AssertFiveCalledWith(t, match.Context(), match.Regexp(`^group-`))
+		}},
+		{"contains", true, func(t testing.TB) bool {
+			return iface.AssertOneCalledWith(t, match.Contains("irs"), match.Contains("b"))
+		}},
+		{"contains mismatch", false, func(t testing.TB) bool {
+			return iface.AssertOneCalledWith(t, match.Any(), match.Contains("c"))
+		}},
+		{"func", true, func(t testing.TB) bool {
+			return iface.AssertFourCalledWith(t, match.Func(func(x c.Int) bool { return x > 3 }))
+		}},
+		{"func mismatch", false, func(t testing.TB) bool {
+			return iface.AssertFourCalledWith(t, match.Func(func(x c.Int) bool { return x > 4 }))
+		}},
+		{"func wrong type", false, func(t testing.TB) bool {
+			return iface.AssertFourCalledWith(t, match.Func(func(x string) bool { return true }))
+		}},
+		{"context mismatch", false, func(t testing.TB) bool {
+			return iface.AssertFiveCalledWith(t, match.Context(), match.Eq("user-2"))
+		}},
+	}
+	for _, test := range tests {
+		ft := &fakeT{}
+		if ok := test.fn(ft); ok != test.ok {
+			t.Errorf("%v: ok = %v, want %v", test.name, ok, test.ok)
+		}
+		if failed := len(ft.errors) > 0; failed == test.ok {
+			t.Errorf("%v: errors = %v", test.name, ft.errors)
+		}
+	}
+
+	ft := &fakeT{}
+	iface.AssertFiveCalledWith(ft, match.Context(), match.Eq("user-2"))
+	want := []string{`mocker: MockIface.Five wasn't called with (any context.Context, "user-2")`}
+	if !reflect.DeepEqual(ft.errors, want) {
+		t.Errorf("errors = %v, want %v", ft.errors, want)
+	}
+}
+
+func TestExpectMatchers(t *testing.T) {
+	iface := &ExpectIface{}
+	iface.ExpectFive(match.Context(), match.Regexp("^a")).Return(1, nil).Times(2)
+	for _, id := range []string{"a1", "a2"} {
+		if n, _ := iface.Five(context.Background(), id); n != 1 {
+			t.Errorf("Five(%v) = %v, want %v", id, n, 1)
+		}
+	}
+	if !iface.AssertExpectations(t) {
+		t.Errorf("AssertExpectations() = %v, want %v", false, true)
+	}
+}
@@ -0,0 +1,587 @@
+// Code generated by mocker. DO NOT EDIT.
+// github.com/travisjeffery/mocker
+// Source: test/in.go
+
+package mock
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/travisjeffery/mocker"
+	"github.com/travisjeffery/mocker/test"
+)
+
+// MockEdge is a mock of Edge interface
+type MockEdge struct {
+	lockNext sync.Mutex
+	NextFunc MockEdgeNextFunc
+
+	lockunexported sync.Mutex
+	unexportedFunc MockEdgeunexportedFunc
+
+	calls struct {
+		Next       []*MockEdgeNextCall
+		unexported []*MockEdgeunexportedCall
+	}
+	seqs struct {
+		Next       []uint64
+		unexported []uint64
+	}
+	conds struct {
+		Next       *sync.Cond
+		unexported *sync.Cond
+	}
+	onCalls struct {
+		Next       map[int]MockEdgeNextFunc
+		unexported map[int]MockEdgeunexportedFunc
+	}
+	queued struct {
+		Next       []MockEdgeNextFunc
+		unexported []MockEdgeunexportedFunc
+	}
+	unstubbed struct {
+		Next       int
+		unexported int
+	}
+	t testing.TB
+}
+
+// NewMockEdge returns a MockEdge that reports calls to methods without a
+// func through t, rather than panicking, and verifies it was used as
+// stubbed when the test's done.
+func NewMockEdge(t testing.TB) *MockEdge {
+	m := &MockEdge{t: t}
+	t.Cleanup(func() {
+		m.Verify(t)
+	})
+	return m
+}
+
+// MockEdgeNextFunc is the func MockEdge.Next calls.
+type MockEdgeNextFunc func() test.Edge
+
+// MockEdgeNextCall is a call made to MockEdge.Next.
+type MockEdgeNextCall struct {
+	Ret0 test.Edge
+
+	// Panic is the value the call panicked with, if it did.
+	Panic interface{}
+}
+
+// Next mocks base method by wrapping the associated func.
+func (m *MockEdge) Next() test.Edge {
+	m.lockNext.Lock()
+	fn := m.NextFunc
+	if onCall, ok := m.onCalls.Next[len(m.calls.Next)]; ok {
+		fn = onCall
+		delete(m.onCalls.Next, len(m.calls.Next))
+	} else if len(m.queued.Next) > 0 {
+		fn = m.queued.Next[0]
+		m.queued.Next = m.queued.Next[1:]
+	}
+	if fn == nil {
+		if m.t == nil {
+			m.lockNext.Unlock()
+			panic("mocker: MockEdge.NextFunc is nil but MockEdge.Next was called.")
+		}
+		m.unstubbed.Next++
+	}
+	call := &MockEdgeNextCall{}
+	m.calls.Next = append(m.calls.Next, call)
+	m.seqs.Next = append(m.seqs.Next, mocker.Sequence())
+	if m.conds.Next != nil {
+		m.conds.Next.Broadcast()
+	}
+	m.lockNext.Unlock()
+
+	var ret0 test.Edge
+	defer func() {
+		r := recover()
+		m.lockNext.Lock()
+		call.Ret0 = ret0
+		call.Panic = r
+		m.lockNext.Unlock()
+		if r != nil {
+			panic(r)
+		}
+	}()
+
+	if fn == nil {
+		return ret0
+	}
+
+	ret0 = fn()
+	return ret0
+}
+
+// NextCalled returns true if Next was called at least once.
+func (m *MockEdge) NextCalled() bool {
+	m.lockNext.Lock()
+	defer m.lockNext.Unlock()
+
+	return len(m.calls.Next) > 0
+}
+
+// NextCalls returns the calls made to Next.
+func (m *MockEdge) NextCalls() []MockEdgeNextCall {
+	m.lockNext.Lock()
+	defer m.lockNext.Unlock()
+
+	var calls []MockEdgeNextCall
+	for _, call := range m.calls.Next {
+		calls = append(calls, *call)
+	}
+	return calls
+}
+
+// NextCallCount returns the number of calls made to Next.
+func (m *MockEdge) NextCallCount() int {
+	m.lockNext.Lock()
+	defer m.lockNext.Unlock()
+
+	return len(m.calls.Next)
+}
+
+// NextCallAt returns the i'th call made to Next, counting from 0, failing the
+// test through t if there weren't that many calls.
+func (m *MockEdge) NextCallAt(t testing.TB, i int) MockEdgeNextCall {
+	t.Helper()
+	m.lockNext.Lock()
+	defer m.lockNext.Unlock()
+
+	if i < 0 || i >= len(m.calls.Next) {
+		t.Fatalf("mocker: MockEdge.Next was called %v times, wanted call %v", len(m.calls.Next), i)
+	}
+	return *m.calls.Next[i]
+}
+
+// NextLastCall returns the last call made to Next, failing the test through t
+// if there weren't any.
+func (m *MockEdge) NextLastCall(t testing.TB) MockEdgeNextCall {
+	t.Helper()
+	m.lockNext.Lock()
+	defer m.lockNext.Unlock()
+
+	if len(m.calls.Next) == 0 {
+		t.Fatalf("mocker: MockEdge.Next wasn't called")
+	}
+	return *m.calls.Next[len(m.calls.Next)-1]
+}
+
+// NextCallsWhere returns the calls made to Next that fn returns true for.
+func (m *MockEdge) NextCallsWhere(fn func(MockEdgeNextCall) bool) []MockEdgeNextCall {
+	var calls []MockEdgeNextCall
+	for _, call := range m.NextCalls() {
+		if fn(call) {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// AssertNextCalledWith reports through t unless Next was called with args
+// matching the matchers, and returns whether it was.
+func (m *MockEdge) AssertNextCalledWith(t testing.TB) bool {
+	t.Helper()
+	m.lockNext.Lock()
+	defer m.lockNext.Unlock()
+
+	if len(m.calls.Next) > 0 {
+		return true
+	}
+	t.Errorf("mocker: MockEdge.Next wasn't called")
+	return false
+}
+
+// NextCall describes the calls made to Next with args matching args, which
+// may be match.Matchers, for asserting on their order with mocker.InOrder.
+func (m *MockEdge) NextCall() mocker.Call {
+	m.lockNext.Lock()
+	defer m.lockNext.Unlock()
+
+	var seqs []uint64
+	seqs = append(seqs, m.seqs.Next...)
+	return mocker.Call{
+		Desc: mocker.Describe("MockEdge.Next"),
+		Seqs: seqs,
+	}
+}
+
+// WaitForNext blocks until Next has been called at least n times, returning
+// nil, or until ctx is done, returning its error.
+func (m *MockEdge) WaitForNext(ctx context.Context, n int) error {
+	m.lockNext.Lock()
+	defer m.lockNext.Unlock()
+
+	if m.conds.Next == nil {
+		m.conds.Next = sync.NewCond(&m.lockNext)
+	}
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		select {
+		case <-ctx.Done():
+			m.lockNext.Lock()
+			m.conds.Next.Broadcast()
+			m.lockNext.Unlock()
+		case <-stop:
+		}
+	}()
+	for len(m.calls.Next) < n {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		m.conds.Next.Wait()
+	}
+	return nil
+}
+
+// ResetNext resets the calls made to Next. Stubs set with OnNextCall for calls
+// yet to be made keep their calls, now counted from the reset.
+func (m *MockEdge) ResetNext() {
+	m.lockNext.Lock()
+	defer m.lockNext.Unlock()
+
+	if n := len(m.calls.Next); n > 0 && m.onCalls.Next != nil {
+		onCalls := make(map[int]MockEdgeNextFunc, len(m.onCalls.Next))
+		for i, fn := range m.onCalls.Next {
+			if i >= n {
+				onCalls[i-n] = fn
+			}
+		}
+		m.onCalls.Next = onCalls
+	}
+	m.calls.Next = nil
+	m.seqs.Next = nil
+	m.unstubbed.Next = 0
+}
+
+// OnNextCall makes the n'th call to Next, counting from 0 like NextCalls, call fn
+// rather than NextFunc.
+func (m *MockEdge) OnNextCall(n int, fn MockEdgeNextFunc) {
+	m.lockNext.Lock()
+	defer m.lockNext.Unlock()
+
+	if m.onCalls.Next == nil {
+		m.onCalls.Next = make(map[int]MockEdgeNextFunc)
+	}
+	m.onCalls.Next[n] = fn
+}
+
+// NextReturnsSequence queues fns for the next calls to Next to call in turn, one
+// per call, after any queued before, then falling back to NextFunc. Calls
+// stubbed with OnNextCall don't use up the queue.
+func (m *MockEdge) NextReturnsSequence(fns ...MockEdgeNextFunc) {
+	m.lockNext.Lock()
+	defer m.lockNext.Unlock()
+
+	m.queued.Next = append(m.queued.Next, fns...)
+}
+
+// WithNext sets NextFunc to fn, taking the lock calls to Next take so it's safe
+// once the mock's shared, and returns the mock.
+func (m *MockEdge) WithNext(fn MockEdgeNextFunc) *MockEdge {
+	m.lockNext.Lock()
+	m.NextFunc = fn
+	m.lockNext.Unlock()
+	return m
+}
+
+// MockEdgeunexportedFunc is the func MockEdge.unexported calls.
+type MockEdgeunexportedFunc func()
+
+// MockEdgeunexportedCall is a call made to MockEdge.unexported.
+type MockEdgeunexportedCall struct {
+	// Panic is the value the call panicked with, if it did.
+	Panic interface{}
+}
+
+// unexported mocks base method by wrapping the associated func.
+func (m *MockEdge) unexported() {
+	m.lockunexported.Lock()
+	fn := m.unexportedFunc
+	if onCall, ok := m.onCalls.unexported[len(m.calls.unexported)]; ok {
+		fn = onCall
+		delete(m.onCalls.unexported, len(m.calls.unexported))
+	} else if len(m.queued.unexported) > 0 {
+		fn = m.queued.unexported[0]
+		m.queued.unexported = m.queued.unexported[1:]
+	}
+	if fn == nil {
+		if m.t == nil {
+			m.lockunexported.Unlock()
+			panic("mocker: MockEdge.unexportedFunc is nil but MockEdge.unexported was called.")
+		}
+		m.unstubbed.unexported++
+	}
+	call := &MockEdgeunexportedCall{}
+	m.calls.unexported = append(m.calls.unexported, call)
+	m.seqs.unexported = append(m.seqs.unexported, mocker.Sequence())
+	if m.conds.unexported != nil {
+		m.conds.unexported.Broadcast()
+	}
+	m.lockunexported.Unlock()
+
+	defer func() {
+		r := recover()
+		m.lockunexported.Lock()
+		call.Panic = r
+		m.lockunexported.Unlock()
+		if r != nil {
+			panic(r)
+		}
+	}()
+
+	if fn == nil {
+		return
+	}
+
+	fn()
+}
+
+// unexportedCalled returns true if unexported was called at least once.
+func (m *MockEdge) unexportedCalled() bool {
+	m.lockunexported.Lock()
+	defer m.lockunexported.Unlock()
+
+	return len(m.calls.unexported) > 0
+}
+
+// unexportedCalls returns the calls made to unexported.
+func (m *MockEdge) unexportedCalls() []MockEdgeunexportedCall {
+	m.lockunexported.Lock()
+	defer m.lockunexported.Unlock()
+
+	var calls []MockEdgeunexportedCall
+	for _, call := range m.calls.unexported {
+		calls = append(calls, *call)
+	}
+	return calls
+}
+
+// unexportedCallCount returns the number of calls made to unexported.
+func (m *MockEdge) unexportedCallCount() int {
+	m.lockunexported.Lock()
+	defer m.lockunexported.Unlock()
+
+	return len(m.calls.unexported)
+}
+
+// unexportedCallAt returns the i'th call made to unexported, counting from 0, failing the
+// test through t if there weren't that many calls.
+func (m *MockEdge) unexportedCallAt(t testing.TB, i int) MockEdgeunexportedCall {
+	t.Helper()
+	m.lockunexported.Lock()
+	defer m.lockunexported.Unlock()
+
+	if i < 0 || i >= len(m.calls.unexported) {
+		t.Fatalf("mocker: MockEdge.unexported was called %v times, wanted call %v", len(m.calls.unexported), i)
+	}
+	return *m.calls.unexported[i]
+}
+
+// unexportedLastCall returns the last call made to unexported, failing the test through t
+// if there weren't any.
+func (m *MockEdge) unexportedLastCall(t testing.TB) MockEdgeunexportedCall {
+	t.Helper()
+	m.lockunexported.Lock()
+	defer m.lockunexported.Unlock()
+
+	if len(m.calls.unexported) == 0 {
+		t.Fatalf("mocker: MockEdge.unexported wasn't called")
+	}
+	return *m.calls.unexported[len(m.calls.unexported)-1]
+}
+
+// unexportedCallsWhere returns the calls made to unexported that fn returns true for.
+func (m *MockEdge) unexportedCallsWhere(fn func(MockEdgeunexportedCall) bool) []MockEdgeunexportedCall {
+	var calls []MockEdgeunexportedCall
+	for _, call := range m.unexportedCalls() {
+		if fn(call) {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// AssertunexportedCalledWith reports through t unless unexported was called with args
+// matching the matchers, and returns whether it was.
+func (m *MockEdge) AssertunexportedCalledWith(t testing.TB) bool {
+	t.Helper()
+	m.lockunexported.Lock()
+	defer m.lockunexported.Unlock()
+
+	if len(m.calls.unexported) > 0 {
+		return true
+	}
+	t.Errorf("mocker: MockEdge.unexported wasn't called")
+	return false
+}
+
+// unexportedCall describes the calls made to unexported with args matching args, which
+// may be match.Matchers, for asserting on their order with mocker.InOrder.
+func (m *MockEdge) unexportedCall() mocker.Call {
+	m.lockunexported.Lock()
+	defer m.lockunexported.Unlock()
+
+	var seqs []uint64
+	seqs = append(seqs, m.seqs.unexported...)
+	return mocker.Call{
+		Desc: mocker.Describe("MockEdge.unexported"),
+		Seqs: seqs,
+	}
+}
+
+// WaitForunexported blocks until unexported has been called at least n times, returning
+// nil, or until ctx is done, returning its error.
+func (m *MockEdge) WaitForunexported(ctx context.Context, n int) error {
+	m.lockunexported.Lock()
+	defer m.lockunexported.Unlock()
+
+	if m.conds.unexported == nil {
+		m.conds.unexported = sync.NewCond(&m.lockunexported)
+	}
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		select {
+		case <-ctx.Done():
+			m.lockunexported.Lock()
+			m.conds.unexported.Broadcast()
+			m.lockunexported.Unlock()
+		case <-stop:
+		}
+	}()
+	for len(m.calls.unexported) < n {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		m.conds.unexported.Wait()
+	}
+	return nil
+}
+
+// Resetunexported resets the calls made to unexported. Stubs set with OnunexportedCall for calls
+// yet to be made keep their calls, now counted from the reset.
+func (m *MockEdge) Resetunexported() {
+	m.lockunexported.Lock()
+	defer m.lockunexported.Unlock()
+
+	if n := len(m.calls.unexported); n > 0 && m.onCalls.unexported != nil {
+		onCalls := make(map[int]MockEdgeunexportedFunc, len(m.onCalls.unexported))
+		for i, fn := range m.onCalls.unexported {
+			if i >= n {
+				onCalls[i-n] = fn
+			}
+		}
+		m.onCalls.unexported = onCalls
+	}
+	m.calls.unexported = nil
+	m.seqs.unexported = nil
+	m.unstubbed.unexported = 0
+}
+
+// OnunexportedCall makes the n'th call to unexported, counting from 0 like unexportedCalls, call fn
+// rather than unexportedFunc.
+func (m *MockEdge) OnunexportedCall(n int, fn MockEdgeunexportedFunc) {
+	m.lockunexported.Lock()
+	defer m.lockunexported.Unlock()
+
+	if m.onCalls.unexported == nil {
+		m.onCalls.unexported = make(map[int]MockEdgeunexportedFunc)
+	}
+	m.onCalls.unexported[n] = fn
+}
+
+// unexportedReturnsSequence queues fns for the next calls to unexported to call in turn, one
+// per call, after any queued before, then falling back to unexportedFunc. Calls
+// stubbed with OnunexportedCall don't use up the queue.
+func (m *MockEdge) unexportedReturnsSequence(fns ...MockEdgeunexportedFunc) {
+	m.lockunexported.Lock()
+	defer m.lockunexported.Unlock()
+
+	m.queued.unexported = append(m.queued.unexported, fns...)
+}
+
+// Withunexported sets unexportedFunc to fn, taking the lock calls to unexported take so it's safe
+// once the mock's shared, and returns the mock.
+func (m *MockEdge) Withunexported(fn MockEdgeunexportedFunc) *MockEdge {
+	m.lockunexported.Lock()
+	m.unexportedFunc = fn
+	m.lockunexported.Unlock()
+	return m
+}
+
+// Reset resets the calls made to the mocked methods.
+func (m *MockEdge) Reset() {
+	m.ResetNext()
+	m.Resetunexported()
+}
+
+// ResetStubs clears the funcs and per-call stubs of the mocked methods.
+func (m *MockEdge) ResetStubs() {
+	m.lockNext.Lock()
+	m.NextFunc = nil
+	m.onCalls.Next = nil
+	m.queued.Next = nil
+	m.lockNext.Unlock()
+	m.lockunexported.Lock()
+	m.unexportedFunc = nil
+	m.onCalls.unexported = nil
+	m.queued.unexported = nil
+	m.lockunexported.Unlock()
+}
+
+// ResetAll resets the calls made to the mocked methods and clears their
+// funcs and per-call stubs.
+func (m *MockEdge) ResetAll() {
+	m.Reset()
+	m.ResetStubs()
+}
+
+// Verify reports through t any calls made to methods without a func, funcs
+// and per-call stubs that weren't called, and unmet expectations. It returns
+// whether there were none.
+func (m *MockEdge) Verify(t testing.TB) bool {
+	t.Helper()
+	ok := true
+	m.lockNext.Lock()
+	if m.unstubbed.Next > 0 {
+		t.Errorf("mocker: MockEdge.Next was called %v times without a func", m.unstubbed.Next)
+		ok = false
+	}
+	if m.NextFunc != nil && len(m.calls.Next) == 0 {
+		t.Errorf("mocker: MockEdge.NextFunc is set but Next wasn't called")
+		ok = false
+	}
+	for n := range m.onCalls.Next {
+		t.Errorf("mocker: MockEdge.Next's stub for call %v wasn't called", n)
+		ok = false
+	}
+	if len(m.queued.Next) > 0 {
+		t.Errorf("mocker: MockEdge.Next's last %v stubs in sequence weren't called", len(m.queued.Next))
+		ok = false
+	}
+	m.lockNext.Unlock()
+	m.lockunexported.Lock()
+	if m.unstubbed.unexported > 0 {
+		t.Errorf("mocker: MockEdge.unexported was called %v times without a func", m.unstubbed.unexported)
+		ok = false
+	}
+	if m.unexportedFunc != nil && len(m.calls.unexported) == 0 {
+		t.Errorf("mocker: MockEdge.unexportedFunc is set but unexported wasn't called")
+		ok = false
+	}
+	for n := range m.onCalls.unexported {
+		t.Errorf("mocker: MockEdge.unexported's stub for call %v wasn't called", n)
+		ok = false
+	}
+	if len(m.queued.unexported) > 0 {
+		t.Errorf("mocker: MockEdge.unexported's last %v stubs in sequence weren't called", len(m.queued.unexported))
+		ok = false
+	}
+	m.lockunexported.Unlock()
+	return ok
+}
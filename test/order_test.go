@@ -0,0 +1,69 @@
+package test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/travisjeffery/mocker"
+	"github.com/travisjeffery/mocker/pkg/mocker/match"
+	"github.com/travisjeffery/mocker/test/c"
+)
+
+func TestInOrder(t *testing.T) {
+	iface := &MockIface{
+		TwoFunc: func(x, y int) int {
+			return x + y
+		},
+		FiveFunc: func(ctx context.Context, id string) (int, error) {
+			return 0, nil
+		},
+	}
+	shadow := &MockShadow{
+		FiveFunc: func(m int, call string, sync bool, c c.Int, _ int, arg4 int) {
+		},
+	}
+	iface.Five(context.Background(), "begin")
+	shadow.Five(1, "write", false, 0, 0, 0)
+	iface.Two(1, 2)
+	iface.Five(context.Background(), "commit")
+
+	if !mocker.InOrder(t,
+		iface.FiveCall(match.Context(), "begin"),
+		shadow.FiveCall(match.Any(), "write", match.Any(), match.Any(), match.Any(), match.Any()),
+		iface.TwoCall(1, 2),
+		iface.FiveCall(match.Context(), "commit"),
+	) {
+		t.Errorf("InOrder() = %v, want %v", false, true)
+	}
+	// Any call to Five fits the order, as long as one comes after Two.
+	if !mocker.InOrder(t, iface.TwoCall(match.Any(), match.Any()), iface.FiveCall(match.Any(), match.Any())) {
+		t.Errorf("InOrder() = %v, want %v", false, true)
+	}
+
+	tests := []struct {
+		name  string
+		calls []mocker.Call
+		want  string
+	}{
+		{
+			name:  "out of order",
+			calls: []mocker.Call{iface.FiveCall(match.Any(), "commit"), iface.TwoCall(1, 2)},
+			want:  `mocker: MockIface.Two(1, 2) wasn't called after MockIface.Five(any, "commit")`,
+		},
+		{
+			name:  "not called",
+			calls: []mocker.Call{iface.TwoCall(3, 4)},
+			want:  `mocker: MockIface.Two(3, 4) wasn't called`,
+		},
+	}
+	for _, test := range tests {
+		ft := &fakeT{}
+		if mocker.InOrder(ft, test.calls...) {
+			t.Errorf("%v: InOrder() = %v, want %v", test.name, true, false)
+		}
+		if want := []string{test.want}; !reflect.DeepEqual(ft.errors, want) {
+			t.Errorf("%v: errors = %v, want %v", test.name, ft.errors, want)
+		}
+	}
+}
@@ -5,65 +5,158 @@
 package test
 
 import (
-	sync "sync"
+	"context"
+	"sync"
+	"testing"
 
-	github_com_travisjeffery_mocker_test_a "github.com/travisjeffery/mocker/test/a"
-	github_com_travisjeffery_mocker_test_b "github.com/travisjeffery/mocker/test/b"
-	github_com_travisjeffery_mocker_test_c "github.com/travisjeffery/mocker/test/c"
+	"github.com/travisjeffery/mocker"
+	"github.com/travisjeffery/mocker/pkg/mocker/match"
+	av1 "github.com/travisjeffery/mocker/test/a"
+	bv1 "github.com/travisjeffery/mocker/test/b"
+	"github.com/travisjeffery/mocker/test/c"
+	d "github.com/travisjeffery/mocker/test/d"
 )
 
 // MockIface is a mock of Iface interface
 type MockIface struct {
 	lockOne sync.Mutex
-	OneFunc func(str string, variadic ...string) (string, []string)
+	OneFunc MockIfaceOneFunc
 
 	lockTwo sync.Mutex
-	TwoFunc func(arg0, arg1 int) int
+	TwoFunc MockIfaceTwoFunc
 
 	lockThree sync.Mutex
-	ThreeFunc func(arg0 github_com_travisjeffery_mocker_test_a.Int) github_com_travisjeffery_mocker_test_b.Str
+	ThreeFunc MockIfaceThreeFunc
 
 	lockFour sync.Mutex
-	FourFunc func(arg0 github_com_travisjeffery_mocker_test_c.Int)
+	FourFunc MockIfaceFourFunc
+
+	lockFive sync.Mutex
+	FiveFunc MockIfaceFiveFunc
 
 	calls struct {
-		One []struct {
-			Str      string
-			Variadic []string
-		}
-		Two []struct {
-			Arg0 int
-			Arg1 int
-		}
-		Three []struct {
-			Arg0 github_com_travisjeffery_mocker_test_a.Int
-		}
-		Four []struct {
-			Arg0 github_com_travisjeffery_mocker_test_c.Int
-		}
+		One   []*MockIfaceOneCall
+		Two   []*MockIfaceTwoCall
+		Three []*MockIfaceThreeCall
+		Four  []*MockIfaceFourCall
+		Five  []*MockIfaceFiveCall
+	}
+	seqs struct {
+		One   []uint64
+		Two   []uint64
+		Three []uint64
+		Four  []uint64
+		Five  []uint64
+	}
+	conds struct {
+		One   *sync.Cond
+		Two   *sync.Cond
+		Three *sync.Cond
+		Four  *sync.Cond
+		Five  *sync.Cond
+	}
+	onCalls struct {
+		One   map[int]MockIfaceOneFunc
+		Two   map[int]MockIfaceTwoFunc
+		Three map[int]MockIfaceThreeFunc
+		Four  map[int]MockIfaceFourFunc
+		Five  map[int]MockIfaceFiveFunc
 	}
+	queued struct {
+		One   []MockIfaceOneFunc
+		Two   []MockIfaceTwoFunc
+		Three []MockIfaceThreeFunc
+		Four  []MockIfaceFourFunc
+		Five  []MockIfaceFiveFunc
+	}
+	unstubbed struct {
+		One   int
+		Two   int
+		Three int
+		Four  int
+		Five  int
+	}
+	t testing.TB
+}
+
+// MockIface must implement Iface, so the build breaks if it's stale.
+var _ Iface = (*MockIface)(nil)
+
+// NewMockIface returns a MockIface that reports calls to methods without a
+// func through t, rather than panicking, and verifies it was used as
+// stubbed when the test's done.
+func NewMockIface(t testing.TB) *MockIface {
+	m := &MockIface{t: t}
+	t.Cleanup(func() {
+		m.Verify(t)
+	})
+	return m
+}
+
+// MockIfaceOneFunc is the func MockIface.One calls.
+type MockIfaceOneFunc func(str string, variadic ...string) (string, []string)
+
+// MockIfaceOneCall is a call made to MockIface.One.
+type MockIfaceOneCall struct {
+	Str      string
+	Variadic []string
+
+	Ret0 string
+	Ret1 []string
+
+	// Panic is the value the call panicked with, if it did.
+	Panic interface{}
 }
 
 // One mocks base method by wrapping the associated func.
 func (m *MockIface) One(str string, variadic ...string) (string, []string) {
 	m.lockOne.Lock()
-	defer m.lockOne.Unlock()
-
-	if m.OneFunc == nil {
-		panic("mocker: MockIface.OneFunc is nil but MockIface.One was called.")
+	fn := m.OneFunc
+	if onCall, ok := m.onCalls.One[len(m.calls.One)]; ok {
+		fn = onCall
+		delete(m.onCalls.One, len(m.calls.One))
+	} else if len(m.queued.One) > 0 {
+		fn = m.queued.One[0]
+		m.queued.One = m.queued.One[1:]
 	}
-
-	call := struct {
-		Str      string
-		Variadic []string
-	}{
+	if fn == nil {
+		if m.t == nil {
+			m.lockOne.Unlock()
+			panic("mocker: MockIface.OneFunc is nil but MockIface.One was called.")
+		}
+		m.unstubbed.One++
+	}
+	call := &MockIfaceOneCall{
 		Str:      str,
 		Variadic: variadic,
 	}
-
 	m.calls.One = append(m.calls.One, call)
+	m.seqs.One = append(m.seqs.One, mocker.Sequence())
+	if m.conds.One != nil {
+		m.conds.One.Broadcast()
+	}
+	m.lockOne.Unlock()
+
+	var ret0 string
+	var ret1 []string
+	defer func() {
+		r := recover()
+		m.lockOne.Lock()
+		call.Ret0 = ret0
+		call.Ret1 = ret1
+		call.Panic = r
+		m.lockOne.Unlock()
+		if r != nil {
+			panic(r)
+		}
+	}()
+
+	if fn == nil {
+		return ret0, ret1
+	}
 
-	return m.OneFunc(str, variadic...)
+	ret0, ret1 = fn(str, variadic...)
+	return ret0, ret1
 }
 
 // OneCalled returns true if One was called at least once.
@@ -75,36 +168,237 @@ func (m *MockIface) OneCalled() bool {
 }
 
 // OneCalls returns the calls made to One.
-func (m *MockIface) OneCalls() []struct {
-	Str      string
-	Variadic []string
-} {
+func (m *MockIface) OneCalls() []MockIfaceOneCall {
+	m.lockOne.Lock()
+	defer m.lockOne.Unlock()
+
+	var calls []MockIfaceOneCall
+	for _, call := range m.calls.One {
+		calls = append(calls, *call)
+	}
+	return calls
+}
+
+// OneCallCount returns the number of calls made to One.
+func (m *MockIface) OneCallCount() int {
+	m.lockOne.Lock()
+	defer m.lockOne.Unlock()
+
+	return len(m.calls.One)
+}
+
+// OneCallAt returns the i'th call made to One, counting from 0, failing the
+// test through t if there weren't that many calls.
+func (m *MockIface) OneCallAt(t testing.TB, i int) MockIfaceOneCall {
+	t.Helper()
+	m.lockOne.Lock()
+	defer m.lockOne.Unlock()
+
+	if i < 0 || i >= len(m.calls.One) {
+		t.Fatalf("mocker: MockIface.One was called %v times, wanted call %v", len(m.calls.One), i)
+	}
+	return *m.calls.One[i]
+}
+
+// OneLastCall returns the last call made to One, failing the test through t
+// if there weren't any.
+func (m *MockIface) OneLastCall(t testing.TB) MockIfaceOneCall {
+	t.Helper()
+	m.lockOne.Lock()
+	defer m.lockOne.Unlock()
+
+	if len(m.calls.One) == 0 {
+		t.Fatalf("mocker: MockIface.One wasn't called")
+	}
+	return *m.calls.One[len(m.calls.One)-1]
+}
+
+// OneCallsWhere returns the calls made to One that fn returns true for.
+func (m *MockIface) OneCallsWhere(fn func(MockIfaceOneCall) bool) []MockIfaceOneCall {
+	var calls []MockIfaceOneCall
+	for _, call := range m.OneCalls() {
+		if fn(call) {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// AssertOneCalledWith reports through t unless One was called with args
+// matching the matchers, and returns whether it was.
+func (m *MockIface) AssertOneCalledWith(t testing.TB, str, variadic match.Matcher) bool {
+	t.Helper()
+	m.lockOne.Lock()
+	defer m.lockOne.Unlock()
+
+	for _, call := range m.calls.One {
+		if str.Match(call.Str) && variadic.Match(call.Variadic) {
+			return true
+		}
+	}
+	t.Errorf("mocker: MockIface.One wasn't called with (%v, %v)", str, variadic)
+	return false
+}
+
+// OneCall describes the calls made to One with args matching args, which
+// may be match.Matchers, for asserting on their order with mocker.InOrder.
+func (m *MockIface) OneCall(str, variadic interface{}) mocker.Call {
+	m.lockOne.Lock()
+	defer m.lockOne.Unlock()
+
+	var seqs []uint64
+	for i, call := range m.calls.One {
+		if match.Of(str).Match(call.Str) && match.Of(variadic).Match(call.Variadic) {
+			seqs = append(seqs, m.seqs.One[i])
+		}
+	}
+	return mocker.Call{
+		Desc: mocker.Describe("MockIface.One", str, variadic),
+		Seqs: seqs,
+	}
+}
+
+// WaitForOne blocks until One has been called at least n times, returning
+// nil, or until ctx is done, returning its error.
+func (m *MockIface) WaitForOne(ctx context.Context, n int) error {
+	m.lockOne.Lock()
+	defer m.lockOne.Unlock()
+
+	if m.conds.One == nil {
+		m.conds.One = sync.NewCond(&m.lockOne)
+	}
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		select {
+		case <-ctx.Done():
+			m.lockOne.Lock()
+			m.conds.One.Broadcast()
+			m.lockOne.Unlock()
+		case <-stop:
+		}
+	}()
+	for len(m.calls.One) < n {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		m.conds.One.Wait()
+	}
+	return nil
+}
+
+// ResetOne resets the calls made to One. Stubs set with OnOneCall for calls
+// yet to be made keep their calls, now counted from the reset.
+func (m *MockIface) ResetOne() {
+	m.lockOne.Lock()
+	defer m.lockOne.Unlock()
+
+	if n := len(m.calls.One); n > 0 && m.onCalls.One != nil {
+		onCalls := make(map[int]MockIfaceOneFunc, len(m.onCalls.One))
+		for i, fn := range m.onCalls.One {
+			if i >= n {
+				onCalls[i-n] = fn
+			}
+		}
+		m.onCalls.One = onCalls
+	}
+	m.calls.One = nil
+	m.seqs.One = nil
+	m.unstubbed.One = 0
+}
+
+// OnOneCall makes the n'th call to One, counting from 0 like OneCalls, call fn
+// rather than OneFunc.
+func (m *MockIface) OnOneCall(n int, fn MockIfaceOneFunc) {
+	m.lockOne.Lock()
+	defer m.lockOne.Unlock()
+
+	if m.onCalls.One == nil {
+		m.onCalls.One = make(map[int]MockIfaceOneFunc)
+	}
+	m.onCalls.One[n] = fn
+}
+
+// OneReturnsSequence queues fns for the next calls to One to call in turn, one
+// per call, after any queued before, then falling back to OneFunc. Calls
+// stubbed with OnOneCall don't use up the queue.
+func (m *MockIface) OneReturnsSequence(fns ...MockIfaceOneFunc) {
 	m.lockOne.Lock()
 	defer m.lockOne.Unlock()
 
-	return m.calls.One
+	m.queued.One = append(m.queued.One, fns...)
+}
+
+// WithOne sets OneFunc to fn, taking the lock calls to One take so it's safe
+// once the mock's shared, and returns the mock.
+func (m *MockIface) WithOne(fn MockIfaceOneFunc) *MockIface {
+	m.lockOne.Lock()
+	m.OneFunc = fn
+	m.lockOne.Unlock()
+	return m
+}
+
+// MockIfaceTwoFunc is the func MockIface.Two calls.
+type MockIfaceTwoFunc func(arg0, arg1 int) int
+
+// MockIfaceTwoCall is a call made to MockIface.Two.
+type MockIfaceTwoCall struct {
+	Arg0 int
+	Arg1 int
+
+	Ret0 int
+
+	// Panic is the value the call panicked with, if it did.
+	Panic interface{}
 }
 
 // Two mocks base method by wrapping the associated func.
 func (m *MockIface) Two(arg0, arg1 int) int {
 	m.lockTwo.Lock()
-	defer m.lockTwo.Unlock()
-
-	if m.TwoFunc == nil {
-		panic("mocker: MockIface.TwoFunc is nil but MockIface.Two was called.")
+	fn := m.TwoFunc
+	if onCall, ok := m.onCalls.Two[len(m.calls.Two)]; ok {
+		fn = onCall
+		delete(m.onCalls.Two, len(m.calls.Two))
+	} else if len(m.queued.Two) > 0 {
+		fn = m.queued.Two[0]
+		m.queued.Two = m.queued.Two[1:]
 	}
-
-	call := struct {
-		Arg0 int
-		Arg1 int
-	}{
+	if fn == nil {
+		if m.t == nil {
+			m.lockTwo.Unlock()
+			panic("mocker: MockIface.TwoFunc is nil but MockIface.Two was called.")
+		}
+		m.unstubbed.Two++
+	}
+	call := &MockIfaceTwoCall{
 		Arg0: arg0,
 		Arg1: arg1,
 	}
-
 	m.calls.Two = append(m.calls.Two, call)
+	m.seqs.Two = append(m.seqs.Two, mocker.Sequence())
+	if m.conds.Two != nil {
+		m.conds.Two.Broadcast()
+	}
+	m.lockTwo.Unlock()
+
+	var ret0 int
+	defer func() {
+		r := recover()
+		m.lockTwo.Lock()
+		call.Ret0 = ret0
+		call.Panic = r
+		m.lockTwo.Unlock()
+		if r != nil {
+			panic(r)
+		}
+	}()
 
-	return m.TwoFunc(arg0, arg1)
+	if fn == nil {
+		return ret0
+	}
+
+	ret0 = fn(arg0, arg1)
+	return ret0
 }
 
 // TwoCalled returns true if Two was called at least once.
@@ -116,34 +410,235 @@ func (m *MockIface) TwoCalled() bool {
 }
 
 // TwoCalls returns the calls made to Two.
-func (m *MockIface) TwoCalls() []struct {
-	Arg0 int
-	Arg1 int
-} {
+func (m *MockIface) TwoCalls() []MockIfaceTwoCall {
 	m.lockTwo.Lock()
 	defer m.lockTwo.Unlock()
 
-	return m.calls.Two
+	var calls []MockIfaceTwoCall
+	for _, call := range m.calls.Two {
+		calls = append(calls, *call)
+	}
+	return calls
 }
 
-// Three mocks base method by wrapping the associated func.
-func (m *MockIface) Three(arg0 github_com_travisjeffery_mocker_test_a.Int) github_com_travisjeffery_mocker_test_b.Str {
-	m.lockThree.Lock()
-	defer m.lockThree.Unlock()
+// TwoCallCount returns the number of calls made to Two.
+func (m *MockIface) TwoCallCount() int {
+	m.lockTwo.Lock()
+	defer m.lockTwo.Unlock()
+
+	return len(m.calls.Two)
+}
+
+// TwoCallAt returns the i'th call made to Two, counting from 0, failing the
+// test through t if there weren't that many calls.
+func (m *MockIface) TwoCallAt(t testing.TB, i int) MockIfaceTwoCall {
+	t.Helper()
+	m.lockTwo.Lock()
+	defer m.lockTwo.Unlock()
+
+	if i < 0 || i >= len(m.calls.Two) {
+		t.Fatalf("mocker: MockIface.Two was called %v times, wanted call %v", len(m.calls.Two), i)
+	}
+	return *m.calls.Two[i]
+}
+
+// TwoLastCall returns the last call made to Two, failing the test through t
+// if there weren't any.
+func (m *MockIface) TwoLastCall(t testing.TB) MockIfaceTwoCall {
+	t.Helper()
+	m.lockTwo.Lock()
+	defer m.lockTwo.Unlock()
 
-	if m.ThreeFunc == nil {
-		panic("mocker: MockIface.ThreeFunc is nil but MockIface.Three was called.")
+	if len(m.calls.Two) == 0 {
+		t.Fatalf("mocker: MockIface.Two wasn't called")
 	}
+	return *m.calls.Two[len(m.calls.Two)-1]
+}
 
-	call := struct {
-		Arg0 github_com_travisjeffery_mocker_test_a.Int
-	}{
-		Arg0: arg0,
+// TwoCallsWhere returns the calls made to Two that fn returns true for.
+func (m *MockIface) TwoCallsWhere(fn func(MockIfaceTwoCall) bool) []MockIfaceTwoCall {
+	var calls []MockIfaceTwoCall
+	for _, call := range m.TwoCalls() {
+		if fn(call) {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// AssertTwoCalledWith reports through t unless Two was called with args
+// matching the matchers, and returns whether it was.
+func (m *MockIface) AssertTwoCalledWith(t testing.TB, arg0, arg1 match.Matcher) bool {
+	t.Helper()
+	m.lockTwo.Lock()
+	defer m.lockTwo.Unlock()
+
+	for _, call := range m.calls.Two {
+		if arg0.Match(call.Arg0) && arg1.Match(call.Arg1) {
+			return true
+		}
+	}
+	t.Errorf("mocker: MockIface.Two wasn't called with (%v, %v)", arg0, arg1)
+	return false
+}
+
+// TwoCall describes the calls made to Two with args matching args, which
+// may be match.Matchers, for asserting on their order with mocker.InOrder.
+func (m *MockIface) TwoCall(arg0, arg1 interface{}) mocker.Call {
+	m.lockTwo.Lock()
+	defer m.lockTwo.Unlock()
+
+	var seqs []uint64
+	for i, call := range m.calls.Two {
+		if match.Of(arg0).Match(call.Arg0) && match.Of(arg1).Match(call.Arg1) {
+			seqs = append(seqs, m.seqs.Two[i])
+		}
+	}
+	return mocker.Call{
+		Desc: mocker.Describe("MockIface.Two", arg0, arg1),
+		Seqs: seqs,
+	}
+}
+
+// WaitForTwo blocks until Two has been called at least n times, returning
+// nil, or until ctx is done, returning its error.
+func (m *MockIface) WaitForTwo(ctx context.Context, n int) error {
+	m.lockTwo.Lock()
+	defer m.lockTwo.Unlock()
+
+	if m.conds.Two == nil {
+		m.conds.Two = sync.NewCond(&m.lockTwo)
+	}
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		select {
+		case <-ctx.Done():
+			m.lockTwo.Lock()
+			m.conds.Two.Broadcast()
+			m.lockTwo.Unlock()
+		case <-stop:
+		}
+	}()
+	for len(m.calls.Two) < n {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		m.conds.Two.Wait()
+	}
+	return nil
+}
+
+// ResetTwo resets the calls made to Two. Stubs set with OnTwoCall for calls
+// yet to be made keep their calls, now counted from the reset.
+func (m *MockIface) ResetTwo() {
+	m.lockTwo.Lock()
+	defer m.lockTwo.Unlock()
+
+	if n := len(m.calls.Two); n > 0 && m.onCalls.Two != nil {
+		onCalls := make(map[int]MockIfaceTwoFunc, len(m.onCalls.Two))
+		for i, fn := range m.onCalls.Two {
+			if i >= n {
+				onCalls[i-n] = fn
+			}
+		}
+		m.onCalls.Two = onCalls
+	}
+	m.calls.Two = nil
+	m.seqs.Two = nil
+	m.unstubbed.Two = 0
+}
+
+// OnTwoCall makes the n'th call to Two, counting from 0 like TwoCalls, call fn
+// rather than TwoFunc.
+func (m *MockIface) OnTwoCall(n int, fn MockIfaceTwoFunc) {
+	m.lockTwo.Lock()
+	defer m.lockTwo.Unlock()
+
+	if m.onCalls.Two == nil {
+		m.onCalls.Two = make(map[int]MockIfaceTwoFunc)
 	}
+	m.onCalls.Two[n] = fn
+}
+
+// TwoReturnsSequence queues fns for the next calls to Two to call in turn, one
+// per call, after any queued before, then falling back to TwoFunc. Calls
+// stubbed with OnTwoCall don't use up the queue.
+func (m *MockIface) TwoReturnsSequence(fns ...MockIfaceTwoFunc) {
+	m.lockTwo.Lock()
+	defer m.lockTwo.Unlock()
+
+	m.queued.Two = append(m.queued.Two, fns...)
+}
+
+// WithTwo sets TwoFunc to fn, taking the lock calls to Two take so it's safe
+// once the mock's shared, and returns the mock.
+func (m *MockIface) WithTwo(fn MockIfaceTwoFunc) *MockIface {
+	m.lockTwo.Lock()
+	m.TwoFunc = fn
+	m.lockTwo.Unlock()
+	return m
+}
 
+// MockIfaceThreeFunc is the func MockIface.Three calls.
+type MockIfaceThreeFunc func(arg0 av1.Int) bv1.Str
+
+// MockIfaceThreeCall is a call made to MockIface.Three.
+type MockIfaceThreeCall struct {
+	Arg0 av1.Int
+
+	Ret0 bv1.Str
+
+	// Panic is the value the call panicked with, if it did.
+	Panic interface{}
+}
+
+// Three mocks base method by wrapping the associated func.
+func (m *MockIface) Three(arg0 av1.Int) bv1.Str {
+	m.lockThree.Lock()
+	fn := m.ThreeFunc
+	if onCall, ok := m.onCalls.Three[len(m.calls.Three)]; ok {
+		fn = onCall
+		delete(m.onCalls.Three, len(m.calls.Three))
+	} else if len(m.queued.Three) > 0 {
+		fn = m.queued.Three[0]
+		m.queued.Three = m.queued.Three[1:]
+	}
+	if fn == nil {
+		if m.t == nil {
+			m.lockThree.Unlock()
+			panic("mocker: MockIface.ThreeFunc is nil but MockIface.Three was called.")
+		}
+		m.unstubbed.Three++
+	}
+	call := &MockIfaceThreeCall{
+		Arg0: arg0,
+	}
 	m.calls.Three = append(m.calls.Three, call)
+	m.seqs.Three = append(m.seqs.Three, mocker.Sequence())
+	if m.conds.Three != nil {
+		m.conds.Three.Broadcast()
+	}
+	m.lockThree.Unlock()
+
+	var ret0 bv1.Str
+	defer func() {
+		r := recover()
+		m.lockThree.Lock()
+		call.Ret0 = ret0
+		call.Panic = r
+		m.lockThree.Unlock()
+		if r != nil {
+			panic(r)
+		}
+	}()
 
-	return m.ThreeFunc(arg0)
+	if fn == nil {
+		return ret0
+	}
+
+	ret0 = fn(arg0)
+	return ret0
 }
 
 // ThreeCalled returns true if Three was called at least once.
@@ -155,65 +650,5526 @@ func (m *MockIface) ThreeCalled() bool {
 }
 
 // ThreeCalls returns the calls made to Three.
-func (m *MockIface) ThreeCalls() []struct {
-	Arg0 github_com_travisjeffery_mocker_test_a.Int
-} {
+func (m *MockIface) ThreeCalls() []MockIfaceThreeCall {
 	m.lockThree.Lock()
 	defer m.lockThree.Unlock()
 
-	return m.calls.Three
+	var calls []MockIfaceThreeCall
+	for _, call := range m.calls.Three {
+		calls = append(calls, *call)
+	}
+	return calls
 }
 
-// Four mocks base method by wrapping the associated func.
-func (m *MockIface) Four(arg0 github_com_travisjeffery_mocker_test_c.Int) {
-	m.lockFour.Lock()
-	defer m.lockFour.Unlock()
+// ThreeCallCount returns the number of calls made to Three.
+func (m *MockIface) ThreeCallCount() int {
+	m.lockThree.Lock()
+	defer m.lockThree.Unlock()
 
-	if m.FourFunc == nil {
-		panic("mocker: MockIface.FourFunc is nil but MockIface.Four was called.")
-	}
+	return len(m.calls.Three)
+}
 
-	call := struct {
-		Arg0 github_com_travisjeffery_mocker_test_c.Int
-	}{
-		Arg0: arg0,
+// ThreeCallAt returns the i'th call made to Three, counting from 0, failing the
+// test through t if there weren't that many calls.
+func (m *MockIface) ThreeCallAt(t testing.TB, i int) MockIfaceThreeCall {
+	t.Helper()
+	m.lockThree.Lock()
+	defer m.lockThree.Unlock()
+
+	if i < 0 || i >= len(m.calls.Three) {
+		t.Fatalf("mocker: MockIface.Three was called %v times, wanted call %v", len(m.calls.Three), i)
 	}
+	return *m.calls.Three[i]
+}
 
-	m.calls.Four = append(m.calls.Four, call)
+// ThreeLastCall returns the last call made to Three, failing the test through t
+// if there weren't any.
+func (m *MockIface) ThreeLastCall(t testing.TB) MockIfaceThreeCall {
+	t.Helper()
+	m.lockThree.Lock()
+	defer m.lockThree.Unlock()
 
-	m.FourFunc(arg0)
+	if len(m.calls.Three) == 0 {
+		t.Fatalf("mocker: MockIface.Three wasn't called")
+	}
+	return *m.calls.Three[len(m.calls.Three)-1]
 }
 
-// FourCalled returns true if Four was called at least once.
-func (m *MockIface) FourCalled() bool {
-	m.lockFour.Lock()
-	defer m.lockFour.Unlock()
+// ThreeCallsWhere returns the calls made to Three that fn returns true for.
+func (m *MockIface) ThreeCallsWhere(fn func(MockIfaceThreeCall) bool) []MockIfaceThreeCall {
+	var calls []MockIfaceThreeCall
+	for _, call := range m.ThreeCalls() {
+		if fn(call) {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
 
-	return len(m.calls.Four) > 0
+// AssertThreeCalledWith reports through t unless Three was called with args
+// matching the matchers, and returns whether it was.
+func (m *MockIface) AssertThreeCalledWith(t testing.TB, arg0 match.Matcher) bool {
+	t.Helper()
+	m.lockThree.Lock()
+	defer m.lockThree.Unlock()
+
+	for _, call := range m.calls.Three {
+		if arg0.Match(call.Arg0) {
+			return true
+		}
+	}
+	t.Errorf("mocker: MockIface.Three wasn't called with (%v)", arg0)
+	return false
 }
 
-// FourCalls returns the calls made to Four.
-func (m *MockIface) FourCalls() []struct {
-	Arg0 github_com_travisjeffery_mocker_test_c.Int
-} {
-	m.lockFour.Lock()
-	defer m.lockFour.Unlock()
+// ThreeCall describes the calls made to Three with args matching args, which
+// may be match.Matchers, for asserting on their order with mocker.InOrder.
+func (m *MockIface) ThreeCall(arg0 interface{}) mocker.Call {
+	m.lockThree.Lock()
+	defer m.lockThree.Unlock()
 
-	return m.calls.Four
+	var seqs []uint64
+	for i, call := range m.calls.Three {
+		if match.Of(arg0).Match(call.Arg0) {
+			seqs = append(seqs, m.seqs.Three[i])
+		}
+	}
+	return mocker.Call{
+		Desc: mocker.Describe("MockIface.Three", arg0),
+		Seqs: seqs,
+	}
 }
 
-// Reset resets the calls made to the mocked methods.
-func (m *MockIface) Reset() {
-	m.lockOne.Lock()
-	m.calls.One = nil
-	m.lockOne.Unlock()
-	m.lockTwo.Lock()
-	m.calls.Two = nil
-	m.lockTwo.Unlock()
+// WaitForThree blocks until Three has been called at least n times, returning
+// nil, or until ctx is done, returning its error.
+func (m *MockIface) WaitForThree(ctx context.Context, n int) error {
 	m.lockThree.Lock()
+	defer m.lockThree.Unlock()
+
+	if m.conds.Three == nil {
+		m.conds.Three = sync.NewCond(&m.lockThree)
+	}
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		select {
+		case <-ctx.Done():
+			m.lockThree.Lock()
+			m.conds.Three.Broadcast()
+			m.lockThree.Unlock()
+		case <-stop:
+		}
+	}()
+	for len(m.calls.Three) < n {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		m.conds.Three.Wait()
+	}
+	return nil
+}
+
+// ResetThree resets the calls made to Three. Stubs set with OnThreeCall for calls
+// yet to be made keep their calls, now counted from the reset.
+func (m *MockIface) ResetThree() {
+	m.lockThree.Lock()
+	defer m.lockThree.Unlock()
+
+	if n := len(m.calls.Three); n > 0 && m.onCalls.Three != nil {
+		onCalls := make(map[int]MockIfaceThreeFunc, len(m.onCalls.Three))
+		for i, fn := range m.onCalls.Three {
+			if i >= n {
+				onCalls[i-n] = fn
+			}
+		}
+		m.onCalls.Three = onCalls
+	}
 	m.calls.Three = nil
+	m.seqs.Three = nil
+	m.unstubbed.Three = 0
+}
+
+// OnThreeCall makes the n'th call to Three, counting from 0 like ThreeCalls, call fn
+// rather than ThreeFunc.
+func (m *MockIface) OnThreeCall(n int, fn MockIfaceThreeFunc) {
+	m.lockThree.Lock()
+	defer m.lockThree.Unlock()
+
+	if m.onCalls.Three == nil {
+		m.onCalls.Three = make(map[int]MockIfaceThreeFunc)
+	}
+	m.onCalls.Three[n] = fn
+}
+
+// ThreeReturnsSequence queues fns for the next calls to Three to call in turn, one
+// per call, after any queued before, then falling back to ThreeFunc. Calls
+// stubbed with OnThreeCall don't use up the queue.
+func (m *MockIface) ThreeReturnsSequence(fns ...MockIfaceThreeFunc) {
+	m.lockThree.Lock()
+	defer m.lockThree.Unlock()
+
+	m.queued.Three = append(m.queued.Three, fns...)
+}
+
+// WithThree sets ThreeFunc to fn, taking the lock calls to Three take so it's safe
+// once the mock's shared, and returns the mock.
+func (m *MockIface) WithThree(fn MockIfaceThreeFunc) *MockIface {
+	m.lockThree.Lock()
+	m.ThreeFunc = fn
 	m.lockThree.Unlock()
+	return m
+}
+
+// MockIfaceFourFunc is the func MockIface.Four calls.
+type MockIfaceFourFunc func(arg0 c.Int)
+
+// MockIfaceFourCall is a call made to MockIface.Four.
+type MockIfaceFourCall struct {
+	Arg0 c.Int
+
+	// Panic is the value the call panicked with, if it did.
+	Panic interface{}
+}
+
+// Four mocks base method by wrapping the associated func.
+func (m *MockIface) Four(arg0 c.Int) {
+	m.lockFour.Lock()
+	fn := m.FourFunc
+	if onCall, ok := m.onCalls.Four[len(m.calls.Four)]; ok {
+		fn = onCall
+		delete(m.onCalls.Four, len(m.calls.Four))
+	} else if len(m.queued.Four) > 0 {
+		fn = m.queued.Four[0]
+		m.queued.Four = m.queued.Four[1:]
+	}
+	if fn == nil {
+		if m.t == nil {
+			m.lockFour.Unlock()
+			panic("mocker: MockIface.FourFunc is nil but MockIface.Four was called.")
+		}
+		m.unstubbed.Four++
+	}
+	call := &MockIfaceFourCall{
+		Arg0: arg0,
+	}
+	m.calls.Four = append(m.calls.Four, call)
+	m.seqs.Four = append(m.seqs.Four, mocker.Sequence())
+	if m.conds.Four != nil {
+		m.conds.Four.Broadcast()
+	}
+	m.lockFour.Unlock()
+
+	defer func() {
+		r := recover()
+		m.lockFour.Lock()
+		call.Panic = r
+		m.lockFour.Unlock()
+		if r != nil {
+			panic(r)
+		}
+	}()
+
+	if fn == nil {
+		return
+	}
+
+	fn(arg0)
+}
+
+// FourCalled returns true if Four was called at least once.
+func (m *MockIface) FourCalled() bool {
+	m.lockFour.Lock()
+	defer m.lockFour.Unlock()
+
+	return len(m.calls.Four) > 0
+}
+
+// FourCalls returns the calls made to Four.
+func (m *MockIface) FourCalls() []MockIfaceFourCall {
+	m.lockFour.Lock()
+	defer m.lockFour.Unlock()
+
+	var calls []MockIfaceFourCall
+	for _, call := range m.calls.Four {
+		calls = append(calls, *call)
+	}
+	return calls
+}
+
+// FourCallCount returns the number of calls made to Four.
+func (m *MockIface) FourCallCount() int {
+	m.lockFour.Lock()
+	defer m.lockFour.Unlock()
+
+	return len(m.calls.Four)
+}
+
+// FourCallAt returns the i'th call made to Four, counting from 0, failing the
+// test through t if there weren't that many calls.
+func (m *MockIface) FourCallAt(t testing.TB, i int) MockIfaceFourCall {
+	t.Helper()
+	m.lockFour.Lock()
+	defer m.lockFour.Unlock()
+
+	if i < 0 || i >= len(m.calls.Four) {
+		t.Fatalf("mocker: MockIface.Four was called %v times, wanted call %v", len(m.calls.Four), i)
+	}
+	return *m.calls.Four[i]
+}
+
+// FourLastCall returns the last call made to Four, failing the test through t
+// if there weren't any.
+func (m *MockIface) FourLastCall(t testing.TB) MockIfaceFourCall {
+	t.Helper()
+	m.lockFour.Lock()
+	defer m.lockFour.Unlock()
+
+	if len(m.calls.Four) == 0 {
+		t.Fatalf("mocker: MockIface.Four wasn't called")
+	}
+	return *m.calls.Four[len(m.calls.Four)-1]
+}
+
+// FourCallsWhere returns the calls made to Four that fn returns true for.
+func (m *MockIface) FourCallsWhere(fn func(MockIfaceFourCall) bool) []MockIfaceFourCall {
+	var calls []MockIfaceFourCall
+	for _, call := range m.FourCalls() {
+		if fn(call) {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// AssertFourCalledWith reports through t unless Four was called with args
+// matching the matchers, and returns whether it was.
+func (m *MockIface) AssertFourCalledWith(t testing.TB, arg0 match.Matcher) bool {
+	t.Helper()
+	m.lockFour.Lock()
+	defer m.lockFour.Unlock()
+
+	for _, call := range m.calls.Four {
+		if arg0.Match(call.Arg0) {
+			return true
+		}
+	}
+	t.Errorf("mocker: MockIface.Four wasn't called with (%v)", arg0)
+	return false
+}
+
+// FourCall describes the calls made to Four with args matching args, which
+// may be match.Matchers, for asserting on their order with mocker.InOrder.
+func (m *MockIface) FourCall(arg0 interface{}) mocker.Call {
+	m.lockFour.Lock()
+	defer m.lockFour.Unlock()
+
+	var seqs []uint64
+	for i, call := range m.calls.Four {
+		if match.Of(arg0).Match(call.Arg0) {
+			seqs = append(seqs, m.seqs.Four[i])
+		}
+	}
+	return mocker.Call{
+		Desc: mocker.Describe("MockIface.Four", arg0),
+		Seqs: seqs,
+	}
+}
+
+// WaitForFour blocks until Four has been called at least n times, returning
+// nil, or until ctx is done, returning its error.
+func (m *MockIface) WaitForFour(ctx context.Context, n int) error {
+	m.lockFour.Lock()
+	defer m.lockFour.Unlock()
+
+	if m.conds.Four == nil {
+		m.conds.Four = sync.NewCond(&m.lockFour)
+	}
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		select {
+		case <-ctx.Done():
+			m.lockFour.Lock()
+			m.conds.Four.Broadcast()
+			m.lockFour.Unlock()
+		case <-stop:
+		}
+	}()
+	for len(m.calls.Four) < n {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		m.conds.Four.Wait()
+	}
+	return nil
+}
+
+// ResetFour resets the calls made to Four. Stubs set with OnFourCall for calls
+// yet to be made keep their calls, now counted from the reset.
+func (m *MockIface) ResetFour() {
 	m.lockFour.Lock()
+	defer m.lockFour.Unlock()
+
+	if n := len(m.calls.Four); n > 0 && m.onCalls.Four != nil {
+		onCalls := make(map[int]MockIfaceFourFunc, len(m.onCalls.Four))
+		for i, fn := range m.onCalls.Four {
+			if i >= n {
+				onCalls[i-n] = fn
+			}
+		}
+		m.onCalls.Four = onCalls
+	}
 	m.calls.Four = nil
+	m.seqs.Four = nil
+	m.unstubbed.Four = 0
+}
+
+// OnFourCall makes the n'th call to Four, counting from 0 like FourCalls, call fn
+// rather than FourFunc.
+func (m *MockIface) OnFourCall(n int, fn MockIfaceFourFunc) {
+	m.lockFour.Lock()
+	defer m.lockFour.Unlock()
+
+	if m.onCalls.Four == nil {
+		m.onCalls.Four = make(map[int]MockIfaceFourFunc)
+	}
+	m.onCalls.Four[n] = fn
+}
+
+// FourReturnsSequence queues fns for the next calls to Four to call in turn, one
+// per call, after any queued before, then falling back to FourFunc. Calls
+// stubbed with OnFourCall don't use up the queue.
+func (m *MockIface) FourReturnsSequence(fns ...MockIfaceFourFunc) {
+	m.lockFour.Lock()
+	defer m.lockFour.Unlock()
+
+	m.queued.Four = append(m.queued.Four, fns...)
+}
+
+// WithFour sets FourFunc to fn, taking the lock calls to Four take so it's safe
+// once the mock's shared, and returns the mock.
+func (m *MockIface) WithFour(fn MockIfaceFourFunc) *MockIface {
+	m.lockFour.Lock()
+	m.FourFunc = fn
 	m.lockFour.Unlock()
+	return m
+}
+
+// MockIfaceFiveFunc is the func MockIface.Five calls.
+type MockIfaceFiveFunc func(ctx context.Context, id string) (int, error)
+
+// MockIfaceFiveCall is a call made to MockIface.Five.
+type MockIfaceFiveCall struct {
+	Ctx context.Context
+	Id  string
+
+	Ret0 int
+	Ret1 error
+
+	// Panic is the value the call panicked with, if it did.
+	Panic interface{}
+}
+
+// Five mocks base method by wrapping the associated func.
+func (m *MockIface) Five(ctx context.Context, id string) (int, error) {
+	m.lockFive.Lock()
+	fn := m.FiveFunc
+	if onCall, ok := m.onCalls.Five[len(m.calls.Five)]; ok {
+		fn = onCall
+		delete(m.onCalls.Five, len(m.calls.Five))
+	} else if len(m.queued.Five) > 0 {
+		fn = m.queued.Five[0]
+		m.queued.Five = m.queued.Five[1:]
+	}
+	if fn == nil {
+		if m.t == nil {
+			m.lockFive.Unlock()
+			panic("mocker: MockIface.FiveFunc is nil but MockIface.Five was called.")
+		}
+		m.unstubbed.Five++
+	}
+	call := &MockIfaceFiveCall{
+		Ctx: ctx,
+		Id:  id,
+	}
+	m.calls.Five = append(m.calls.Five, call)
+	m.seqs.Five = append(m.seqs.Five, mocker.Sequence())
+	if m.conds.Five != nil {
+		m.conds.Five.Broadcast()
+	}
+	m.lockFive.Unlock()
+
+	var ret0 int
+	var ret1 error
+	defer func() {
+		r := recover()
+		m.lockFive.Lock()
+		call.Ret0 = ret0
+		call.Ret1 = ret1
+		call.Panic = r
+		m.lockFive.Unlock()
+		if r != nil {
+			panic(r)
+		}
+	}()
+
+	if fn == nil {
+		return ret0, ret1
+	}
+
+	ret0, ret1 = fn(ctx, id)
+	return ret0, ret1
+}
+
+// FiveCalled returns true if Five was called at least once.
+func (m *MockIface) FiveCalled() bool {
+	m.lockFive.Lock()
+	defer m.lockFive.Unlock()
+
+	return len(m.calls.Five) > 0
+}
+
+// FiveCalls returns the calls made to Five.
+func (m *MockIface) FiveCalls() []MockIfaceFiveCall {
+	m.lockFive.Lock()
+	defer m.lockFive.Unlock()
+
+	var calls []MockIfaceFiveCall
+	for _, call := range m.calls.Five {
+		calls = append(calls, *call)
+	}
+	return calls
+}
+
+// FiveCallCount returns the number of calls made to Five.
+func (m *MockIface) FiveCallCount() int {
+	m.lockFive.Lock()
+	defer m.lockFive.Unlock()
+
+	return len(m.calls.Five)
+}
+
+// FiveCallAt returns the i'th call made to Five, counting from 0, failing the
+// test through t if there weren't that many calls.
+func (m *MockIface) FiveCallAt(t testing.TB, i int) MockIfaceFiveCall {
+	t.Helper()
+	m.lockFive.Lock()
+	defer m.lockFive.Unlock()
+
+	if i < 0 || i >= len(m.calls.Five) {
+		t.Fatalf("mocker: MockIface.Five was called %v times, wanted call %v", len(m.calls.Five), i)
+	}
+	return *m.calls.Five[i]
+}
+
+// FiveLastCall returns the last call made to Five, failing the test through t
+// if there weren't any.
+func (m *MockIface) FiveLastCall(t testing.TB) MockIfaceFiveCall {
+	t.Helper()
+	m.lockFive.Lock()
+	defer m.lockFive.Unlock()
+
+	if len(m.calls.Five) == 0 {
+		t.Fatalf("mocker: MockIface.Five wasn't called")
+	}
+	return *m.calls.Five[len(m.calls.Five)-1]
+}
+
+// FiveCallsWhere returns the calls made to Five that fn returns true for.
+func (m *MockIface) FiveCallsWhere(fn func(MockIfaceFiveCall) bool) []MockIfaceFiveCall {
+	var calls []MockIfaceFiveCall
+	for _, call := range m.FiveCalls() {
+		if fn(call) {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// AssertFiveCalledWith reports through t unless Five was called with args
+// matching the matchers, and returns whether it was.
+func (m *MockIface) AssertFiveCalledWith(t testing.TB, ctx, id match.Matcher) bool {
+	t.Helper()
+	m.lockFive.Lock()
+	defer m.lockFive.Unlock()
+
+	for _, call := range m.calls.Five {
+		if ctx.Match(call.Ctx) && id.Match(call.Id) {
+			return true
+		}
+	}
+	t.Errorf("mocker: MockIface.Five wasn't called with (%v, %v)", ctx, id)
+	return false
+}
+
+// FiveCall describes the calls made to Five with args matching args, which
+// may be match.Matchers, for asserting on their order with mocker.InOrder.
+func (m *MockIface) FiveCall(ctx, id interface{}) mocker.Call {
+	m.lockFive.Lock()
+	defer m.lockFive.Unlock()
+
+	var seqs []uint64
+	for i, call := range m.calls.Five {
+		if match.Of(ctx).Match(call.Ctx) && match.Of(id).Match(call.Id) {
+			seqs = append(seqs, m.seqs.Five[i])
+		}
+	}
+	return mocker.Call{
+		Desc: mocker.Describe("MockIface.Five", ctx, id),
+		Seqs: seqs,
+	}
+}
+
+// WaitForFive blocks until Five has been called at least n times, returning
+// nil, or until ctx_2 is done, returning its error.
+func (m *MockIface) WaitForFive(ctx_2 context.Context, n int) error {
+	m.lockFive.Lock()
+	defer m.lockFive.Unlock()
+
+	if m.conds.Five == nil {
+		m.conds.Five = sync.NewCond(&m.lockFive)
+	}
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		select {
+		case <-ctx_2.Done():
+			m.lockFive.Lock()
+			m.conds.Five.Broadcast()
+			m.lockFive.Unlock()
+		case <-stop:
+		}
+	}()
+	for len(m.calls.Five) < n {
+		if err := ctx_2.Err(); err != nil {
+			return err
+		}
+		m.conds.Five.Wait()
+	}
+	return nil
+}
+
+// ResetFive resets the calls made to Five. Stubs set with OnFiveCall for calls
+// yet to be made keep their calls, now counted from the reset.
+func (m *MockIface) ResetFive() {
+	m.lockFive.Lock()
+	defer m.lockFive.Unlock()
+
+	if n := len(m.calls.Five); n > 0 && m.onCalls.Five != nil {
+		onCalls := make(map[int]MockIfaceFiveFunc, len(m.onCalls.Five))
+		for i, fn := range m.onCalls.Five {
+			if i >= n {
+				onCalls[i-n] = fn
+			}
+		}
+		m.onCalls.Five = onCalls
+	}
+	m.calls.Five = nil
+	m.seqs.Five = nil
+	m.unstubbed.Five = 0
+}
+
+// OnFiveCall makes the n'th call to Five, counting from 0 like FiveCalls, call fn
+// rather than FiveFunc.
+func (m *MockIface) OnFiveCall(n int, fn MockIfaceFiveFunc) {
+	m.lockFive.Lock()
+	defer m.lockFive.Unlock()
+
+	if m.onCalls.Five == nil {
+		m.onCalls.Five = make(map[int]MockIfaceFiveFunc)
+	}
+	m.onCalls.Five[n] = fn
+}
+
+// FiveReturnsSequence queues fns for the next calls to Five to call in turn, one
+// per call, after any queued before, then falling back to FiveFunc. Calls
+// stubbed with OnFiveCall don't use up the queue.
+func (m *MockIface) FiveReturnsSequence(fns ...MockIfaceFiveFunc) {
+	m.lockFive.Lock()
+	defer m.lockFive.Unlock()
+
+	m.queued.Five = append(m.queued.Five, fns...)
+}
+
+// WithFive sets FiveFunc to fn, taking the lock calls to Five take so it's safe
+// once the mock's shared, and returns the mock.
+func (m *MockIface) WithFive(fn MockIfaceFiveFunc) *MockIface {
+	m.lockFive.Lock()
+	m.FiveFunc = fn
+	m.lockFive.Unlock()
+	return m
+}
+
+// Reset resets the calls made to the mocked methods.
+func (m *MockIface) Reset() {
+	m.ResetOne()
+	m.ResetTwo()
+	m.ResetThree()
+	m.ResetFour()
+	m.ResetFive()
+}
+
+// ResetStubs clears the funcs and per-call stubs of the mocked methods.
+func (m *MockIface) ResetStubs() {
+	m.lockOne.Lock()
+	m.OneFunc = nil
+	m.onCalls.One = nil
+	m.queued.One = nil
+	m.lockOne.Unlock()
+	m.lockTwo.Lock()
+	m.TwoFunc = nil
+	m.onCalls.Two = nil
+	m.queued.Two = nil
+	m.lockTwo.Unlock()
+	m.lockThree.Lock()
+	m.ThreeFunc = nil
+	m.onCalls.Three = nil
+	m.queued.Three = nil
+	m.lockThree.Unlock()
+	m.lockFour.Lock()
+	m.FourFunc = nil
+	m.onCalls.Four = nil
+	m.queued.Four = nil
+	m.lockFour.Unlock()
+	m.lockFive.Lock()
+	m.FiveFunc = nil
+	m.onCalls.Five = nil
+	m.queued.Five = nil
+	m.lockFive.Unlock()
+}
+
+// ResetAll resets the calls made to the mocked methods and clears their
+// funcs and per-call stubs.
+func (m *MockIface) ResetAll() {
+	m.Reset()
+	m.ResetStubs()
+}
+
+// Verify reports through t any calls made to methods without a func, funcs
+// and per-call stubs that weren't called, and unmet expectations. It returns
+// whether there were none.
+func (m *MockIface) Verify(t testing.TB) bool {
+	t.Helper()
+	ok := true
+	m.lockOne.Lock()
+	if m.unstubbed.One > 0 {
+		t.Errorf("mocker: MockIface.One was called %v times without a func", m.unstubbed.One)
+		ok = false
+	}
+	if m.OneFunc != nil && len(m.calls.One) == 0 {
+		t.Errorf("mocker: MockIface.OneFunc is set but One wasn't called")
+		ok = false
+	}
+	for n := range m.onCalls.One {
+		t.Errorf("mocker: MockIface.One's stub for call %v wasn't called", n)
+		ok = false
+	}
+	if len(m.queued.One) > 0 {
+		t.Errorf("mocker: MockIface.One's last %v stubs in sequence weren't called", len(m.queued.One))
+		ok = false
+	}
+	m.lockOne.Unlock()
+	m.lockTwo.Lock()
+	if m.unstubbed.Two > 0 {
+		t.Errorf("mocker: MockIface.Two was called %v times without a func", m.unstubbed.Two)
+		ok = false
+	}
+	if m.TwoFunc != nil && len(m.calls.Two) == 0 {
+		t.Errorf("mocker: MockIface.TwoFunc is set but Two wasn't called")
+		ok = false
+	}
+	for n := range m.onCalls.Two {
+		t.Errorf("mocker: MockIface.Two's stub for call %v wasn't called", n)
+		ok = false
+	}
+	if len(m.queued.Two) > 0 {
+		t.Errorf("mocker: MockIface.Two's last %v stubs in sequence weren't called", len(m.queued.Two))
+		ok = false
+	}
+	m.lockTwo.Unlock()
+	m.lockThree.Lock()
+	if m.unstubbed.Three > 0 {
+		t.Errorf("mocker: MockIface.Three was called %v times without a func", m.unstubbed.Three)
+		ok = false
+	}
+	if m.ThreeFunc != nil && len(m.calls.Three) == 0 {
+		t.Errorf("mocker: MockIface.ThreeFunc is set but Three wasn't called")
+		ok = false
+	}
+	for n := range m.onCalls.Three {
+		t.Errorf("mocker: MockIface.Three's stub for call %v wasn't called", n)
+		ok = false
+	}
+	if len(m.queued.Three) > 0 {
+		t.Errorf("mocker: MockIface.Three's last %v stubs in sequence weren't called", len(m.queued.Three))
+		ok = false
+	}
+	m.lockThree.Unlock()
+	m.lockFour.Lock()
+	if m.unstubbed.Four > 0 {
+		t.Errorf("mocker: MockIface.Four was called %v times without a func", m.unstubbed.Four)
+		ok = false
+	}
+	if m.FourFunc != nil && len(m.calls.Four) == 0 {
+		t.Errorf("mocker: MockIface.FourFunc is set but Four wasn't called")
+		ok = false
+	}
+	for n := range m.onCalls.Four {
+		t.Errorf("mocker: MockIface.Four's stub for call %v wasn't called", n)
+		ok = false
+	}
+	if len(m.queued.Four) > 0 {
+		t.Errorf("mocker: MockIface.Four's last %v stubs in sequence weren't called", len(m.queued.Four))
+		ok = false
+	}
+	m.lockFour.Unlock()
+	m.lockFive.Lock()
+	if m.unstubbed.Five > 0 {
+		t.Errorf("mocker: MockIface.Five was called %v times without a func", m.unstubbed.Five)
+		ok = false
+	}
+	if m.FiveFunc != nil && len(m.calls.Five) == 0 {
+		t.Errorf("mocker: MockIface.FiveFunc is set but Five wasn't called")
+		ok = false
+	}
+	for n := range m.onCalls.Five {
+		t.Errorf("mocker: MockIface.Five's stub for call %v wasn't called", n)
+		ok = false
+	}
+	if len(m.queued.Five) > 0 {
+		t.Errorf("mocker: MockIface.Five's last %v stubs in sequence weren't called", len(m.queued.Five))
+		ok = false
+	}
+	m.lockFive.Unlock()
+	return ok
+}
+
+// MockShadow is a mock of Shadow interface
+type MockShadow struct {
+	lockFive sync.Mutex
+	FiveFunc MockShadowFiveFunc
+
+	lockSix sync.Mutex
+	SixFunc MockShadowSixFunc
+
+	calls struct {
+		Five []*MockShadowFiveCall
+		Six  []*MockShadowSixCall
+	}
+	seqs struct {
+		Five []uint64
+		Six  []uint64
+	}
+	conds struct {
+		Five *sync.Cond
+		Six  *sync.Cond
+	}
+	onCalls struct {
+		Five map[int]MockShadowFiveFunc
+		Six  map[int]MockShadowSixFunc
+	}
+	queued struct {
+		Five []MockShadowFiveFunc
+		Six  []MockShadowSixFunc
+	}
+	unstubbed struct {
+		Five int
+		Six  int
+	}
+	t testing.TB
+}
+
+// MockShadow must implement Shadow, so the build breaks if it's stale.
+var _ Shadow = (*MockShadow)(nil)
+
+// NewMockShadow returns a MockShadow that reports calls to methods without a
+// func through t, rather than panicking, and verifies it was used as
+// stubbed when the test's done.
+func NewMockShadow(t testing.TB) *MockShadow {
+	m := &MockShadow{t: t}
+	t.Cleanup(func() {
+		m.Verify(t)
+	})
+	return m
+}
+
+// MockShadowFiveFunc is the func MockShadow.Five calls.
+type MockShadowFiveFunc func(m int, call string, sync_2 bool, c_2 c.Int, arg4, arg4_2 int)
+
+// MockShadowFiveCall is a call made to MockShadow.Five.
+type MockShadowFiveCall struct {
+	M      int
+	Call   string
+	Sync   bool
+	C      c.Int
+	Arg4   int
+	Arg4_2 int
+
+	// Panic is the value the call panicked with, if it did.
+	Panic interface{}
+}
+
+// Five mocks base method by wrapping the associated func.
+func (m_2 *MockShadow) Five(m int, call string, sync_2 bool, c_2 c.Int, arg4, arg4_2 int) {
+	m_2.lockFive.Lock()
+	fn := m_2.FiveFunc
+	if onCall, ok := m_2.onCalls.Five[len(m_2.calls.Five)]; ok {
+		fn = onCall
+		delete(m_2.onCalls.Five, len(m_2.calls.Five))
+	} else if len(m_2.queued.Five) > 0 {
+		fn = m_2.queued.Five[0]
+		m_2.queued.Five = m_2.queued.Five[1:]
+	}
+	if fn == nil {
+		if m_2.t == nil {
+			m_2.lockFive.Unlock()
+			panic("mocker: MockShadow.FiveFunc is nil but MockShadow.Five was called.")
+		}
+		m_2.unstubbed.Five++
+	}
+	call_2 := &MockShadowFiveCall{
+		M:      m,
+		Call:   call,
+		Sync:   sync_2,
+		C:      c_2,
+		Arg4:   arg4,
+		Arg4_2: arg4_2,
+	}
+	m_2.calls.Five = append(m_2.calls.Five, call_2)
+	m_2.seqs.Five = append(m_2.seqs.Five, mocker.Sequence())
+	if m_2.conds.Five != nil {
+		m_2.conds.Five.Broadcast()
+	}
+	m_2.lockFive.Unlock()
+
+	defer func() {
+		r := recover()
+		m_2.lockFive.Lock()
+		call_2.Panic = r
+		m_2.lockFive.Unlock()
+		if r != nil {
+			panic(r)
+		}
+	}()
+
+	if fn == nil {
+		return
+	}
+
+	fn(m, call, sync_2, c_2, arg4, arg4_2)
+}
+
+// FiveCalled returns true if Five was called at least once.
+func (m_2 *MockShadow) FiveCalled() bool {
+	m_2.lockFive.Lock()
+	defer m_2.lockFive.Unlock()
+
+	return len(m_2.calls.Five) > 0
+}
+
+// FiveCalls returns the calls made to Five.
+func (m_2 *MockShadow) FiveCalls() []MockShadowFiveCall {
+	m_2.lockFive.Lock()
+	defer m_2.lockFive.Unlock()
+
+	var calls []MockShadowFiveCall
+	for _, call_2 := range m_2.calls.Five {
+		calls = append(calls, *call_2)
+	}
+	return calls
+}
+
+// FiveCallCount returns the number of calls made to Five.
+func (m_2 *MockShadow) FiveCallCount() int {
+	m_2.lockFive.Lock()
+	defer m_2.lockFive.Unlock()
+
+	return len(m_2.calls.Five)
+}
+
+// FiveCallAt returns the i'th call made to Five, counting from 0, failing the
+// test through t if there weren't that many calls.
+func (m_2 *MockShadow) FiveCallAt(t testing.TB, i int) MockShadowFiveCall {
+	t.Helper()
+	m_2.lockFive.Lock()
+	defer m_2.lockFive.Unlock()
+
+	if i < 0 || i >= len(m_2.calls.Five) {
+		t.Fatalf("mocker: MockShadow.Five was called %v times, wanted call %v", len(m_2.calls.Five), i)
+	}
+	return *m_2.calls.Five[i]
+}
+
+// FiveLastCall returns the last call made to Five, failing the test through t
+// if there weren't any.
+func (m_2 *MockShadow) FiveLastCall(t testing.TB) MockShadowFiveCall {
+	t.Helper()
+	m_2.lockFive.Lock()
+	defer m_2.lockFive.Unlock()
+
+	if len(m_2.calls.Five) == 0 {
+		t.Fatalf("mocker: MockShadow.Five wasn't called")
+	}
+	return *m_2.calls.Five[len(m_2.calls.Five)-1]
+}
+
+// FiveCallsWhere returns the calls made to Five that fn returns true for.
+func (m_2 *MockShadow) FiveCallsWhere(fn func(MockShadowFiveCall) bool) []MockShadowFiveCall {
+	var calls []MockShadowFiveCall
+	for _, call_2 := range m_2.FiveCalls() {
+		if fn(call_2) {
+			calls = append(calls, call_2)
+		}
+	}
+	return calls
+}
+
+// AssertFiveCalledWith reports through t unless Five was called with args
+// matching the matchers, and returns whether it was.
+func (m_2 *MockShadow) AssertFiveCalledWith(t testing.TB, m, call, sync_2, c_2, arg4, arg4_2 match.Matcher) bool {
+	t.Helper()
+	m_2.lockFive.Lock()
+	defer m_2.lockFive.Unlock()
+
+	for _, call_2 := range m_2.calls.Five {
+		if m.Match(call_2.M) && call.Match(call_2.Call) && sync_2.Match(call_2.Sync) && c_2.Match(call_2.C) && arg4.Match(call_2.Arg4) && arg4_2.Match(call_2.Arg4_2) {
+			return true
+		}
+	}
+	t.Errorf("mocker: MockShadow.Five wasn't called with (%v, %v, %v, %v, %v, %v)", m, call, sync_2, c_2, arg4, arg4_2)
+	return false
+}
+
+// FiveCall describes the calls made to Five with args matching args, which
+// may be match.Matchers, for asserting on their order with mocker.InOrder.
+func (m_2 *MockShadow) FiveCall(m, call, sync_2, c_2, arg4, arg4_2 interface{}) mocker.Call {
+	m_2.lockFive.Lock()
+	defer m_2.lockFive.Unlock()
+
+	var seqs []uint64
+	for i, call_2 := range m_2.calls.Five {
+		if match.Of(m).Match(call_2.M) && match.Of(call).Match(call_2.Call) && match.Of(sync_2).Match(call_2.Sync) && match.Of(c_2).Match(call_2.C) && match.Of(arg4).Match(call_2.Arg4) && match.Of(arg4_2).Match(call_2.Arg4_2) {
+			seqs = append(seqs, m_2.seqs.Five[i])
+		}
+	}
+	return mocker.Call{
+		Desc: mocker.Describe("MockShadow.Five", m, call, sync_2, c_2, arg4, arg4_2),
+		Seqs: seqs,
+	}
+}
+
+// WaitForFive blocks until Five has been called at least n times, returning
+// nil, or until ctx is done, returning its error.
+func (m_2 *MockShadow) WaitForFive(ctx context.Context, n int) error {
+	m_2.lockFive.Lock()
+	defer m_2.lockFive.Unlock()
+
+	if m_2.conds.Five == nil {
+		m_2.conds.Five = sync.NewCond(&m_2.lockFive)
+	}
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		select {
+		case <-ctx.Done():
+			m_2.lockFive.Lock()
+			m_2.conds.Five.Broadcast()
+			m_2.lockFive.Unlock()
+		case <-stop:
+		}
+	}()
+	for len(m_2.calls.Five) < n {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		m_2.conds.Five.Wait()
+	}
+	return nil
+}
+
+// ResetFive resets the calls made to Five. Stubs set with OnFiveCall for calls
+// yet to be made keep their calls, now counted from the reset.
+func (m_2 *MockShadow) ResetFive() {
+	m_2.lockFive.Lock()
+	defer m_2.lockFive.Unlock()
+
+	if n := len(m_2.calls.Five); n > 0 && m_2.onCalls.Five != nil {
+		onCalls := make(map[int]MockShadowFiveFunc, len(m_2.onCalls.Five))
+		for i, fn := range m_2.onCalls.Five {
+			if i >= n {
+				onCalls[i-n] = fn
+			}
+		}
+		m_2.onCalls.Five = onCalls
+	}
+	m_2.calls.Five = nil
+	m_2.seqs.Five = nil
+	m_2.unstubbed.Five = 0
+}
+
+// OnFiveCall makes the n'th call to Five, counting from 0 like FiveCalls, call fn
+// rather than FiveFunc.
+func (m_2 *MockShadow) OnFiveCall(n int, fn MockShadowFiveFunc) {
+	m_2.lockFive.Lock()
+	defer m_2.lockFive.Unlock()
+
+	if m_2.onCalls.Five == nil {
+		m_2.onCalls.Five = make(map[int]MockShadowFiveFunc)
+	}
+	m_2.onCalls.Five[n] = fn
+}
+
+// FiveReturnsSequence queues fns for the next calls to Five to call in turn, one
+// per call, after any queued before, then falling back to FiveFunc. Calls
+// stubbed with OnFiveCall don't use up the queue.
+func (m_2 *MockShadow) FiveReturnsSequence(fns ...MockShadowFiveFunc) {
+	m_2.lockFive.Lock()
+	defer m_2.lockFive.Unlock()
+
+	m_2.queued.Five = append(m_2.queued.Five, fns...)
+}
+
+// WithFive sets FiveFunc to fn, taking the lock calls to Five take so it's safe
+// once the mock's shared, and returns the mock.
+func (m_2 *MockShadow) WithFive(fn MockShadowFiveFunc) *MockShadow {
+	m_2.lockFive.Lock()
+	m_2.FiveFunc = fn
+	m_2.lockFive.Unlock()
+	return m_2
+}
+
+// MockShadowSixFunc is the func MockShadow.Six calls.
+type MockShadowSixFunc func(cb func(...int) int, opts ...func(...string))
+
+// MockShadowSixCall is a call made to MockShadow.Six.
+type MockShadowSixCall struct {
+	Cb   func(...int) int
+	Opts []func(...string)
+
+	// Panic is the value the call panicked with, if it did.
+	Panic interface{}
+}
+
+// Six mocks base method by wrapping the associated func.
+func (m *MockShadow) Six(cb func(...int) int, opts ...func(...string)) {
+	m.lockSix.Lock()
+	fn := m.SixFunc
+	if onCall, ok := m.onCalls.Six[len(m.calls.Six)]; ok {
+		fn = onCall
+		delete(m.onCalls.Six, len(m.calls.Six))
+	} else if len(m.queued.Six) > 0 {
+		fn = m.queued.Six[0]
+		m.queued.Six = m.queued.Six[1:]
+	}
+	if fn == nil {
+		if m.t == nil {
+			m.lockSix.Unlock()
+			panic("mocker: MockShadow.SixFunc is nil but MockShadow.Six was called.")
+		}
+		m.unstubbed.Six++
+	}
+	call := &MockShadowSixCall{
+		Cb:   cb,
+		Opts: opts,
+	}
+	m.calls.Six = append(m.calls.Six, call)
+	m.seqs.Six = append(m.seqs.Six, mocker.Sequence())
+	if m.conds.Six != nil {
+		m.conds.Six.Broadcast()
+	}
+	m.lockSix.Unlock()
+
+	defer func() {
+		r := recover()
+		m.lockSix.Lock()
+		call.Panic = r
+		m.lockSix.Unlock()
+		if r != nil {
+			panic(r)
+		}
+	}()
+
+	if fn == nil {
+		return
+	}
+
+	fn(cb, opts...)
+}
+
+// SixCalled returns true if Six was called at least once.
+func (m *MockShadow) SixCalled() bool {
+	m.lockSix.Lock()
+	defer m.lockSix.Unlock()
+
+	return len(m.calls.Six) > 0
+}
+
+// SixCalls returns the calls made to Six.
+func (m *MockShadow) SixCalls() []MockShadowSixCall {
+	m.lockSix.Lock()
+	defer m.lockSix.Unlock()
+
+	var calls []MockShadowSixCall
+	for _, call := range m.calls.Six {
+		calls = append(calls, *call)
+	}
+	return calls
+}
+
+// SixCallCount returns the number of calls made to Six.
+func (m *MockShadow) SixCallCount() int {
+	m.lockSix.Lock()
+	defer m.lockSix.Unlock()
+
+	return len(m.calls.Six)
+}
+
+// SixCallAt returns the i'th call made to Six, counting from 0, failing the
+// test through t if there weren't that many calls.
+func (m *MockShadow) SixCallAt(t testing.TB, i int) MockShadowSixCall {
+	t.Helper()
+	m.lockSix.Lock()
+	defer m.lockSix.Unlock()
+
+	if i < 0 || i >= len(m.calls.Six) {
+		t.Fatalf("mocker: MockShadow.Six was called %v times, wanted call %v", len(m.calls.Six), i)
+	}
+	return *m.calls.Six[i]
+}
+
+// SixLastCall returns the last call made to Six, failing the test through t
+// if there weren't any.
+func (m *MockShadow) SixLastCall(t testing.TB) MockShadowSixCall {
+	t.Helper()
+	m.lockSix.Lock()
+	defer m.lockSix.Unlock()
+
+	if len(m.calls.Six) == 0 {
+		t.Fatalf("mocker: MockShadow.Six wasn't called")
+	}
+	return *m.calls.Six[len(m.calls.Six)-1]
+}
+
+// SixCallsWhere returns the calls made to Six that fn returns true for.
+func (m *MockShadow) SixCallsWhere(fn func(MockShadowSixCall) bool) []MockShadowSixCall {
+	var calls []MockShadowSixCall
+	for _, call := range m.SixCalls() {
+		if fn(call) {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// AssertSixCalledWith reports through t unless Six was called with args
+// matching the matchers, and returns whether it was.
+func (m *MockShadow) AssertSixCalledWith(t testing.TB, cb, opts match.Matcher) bool {
+	t.Helper()
+	m.lockSix.Lock()
+	defer m.lockSix.Unlock()
+
+	for _, call := range m.calls.Six {
+		if cb.Match(call.Cb) && opts.Match(call.Opts) {
+			return true
+		}
+	}
+	t.Errorf("mocker: MockShadow.Six wasn't called with (%v, %v)", cb, opts)
+	return false
+}
+
+// SixCall describes the calls made to Six with args matching args, which
+// may be match.Matchers, for asserting on their order with mocker.InOrder.
+func (m *MockShadow) SixCall(cb, opts interface{}) mocker.Call {
+	m.lockSix.Lock()
+	defer m.lockSix.Unlock()
+
+	var seqs []uint64
+	for i, call := range m.calls.Six {
+		if match.Of(cb).Match(call.Cb) && match.Of(opts).Match(call.Opts) {
+			seqs = append(seqs, m.seqs.Six[i])
+		}
+	}
+	return mocker.Call{
+		Desc: mocker.Describe("MockShadow.Six", cb, opts),
+		Seqs: seqs,
+	}
+}
+
+// WaitForSix blocks until Six has been called at least n times, returning
+// nil, or until ctx is done, returning its error.
+func (m *MockShadow) WaitForSix(ctx context.Context, n int) error {
+	m.lockSix.Lock()
+	defer m.lockSix.Unlock()
+
+	if m.conds.Six == nil {
+		m.conds.Six = sync.NewCond(&m.lockSix)
+	}
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		select {
+		case <-ctx.Done():
+			m.lockSix.Lock()
+			m.conds.Six.Broadcast()
+			m.lockSix.Unlock()
+		case <-stop:
+		}
+	}()
+	for len(m.calls.Six) < n {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		m.conds.Six.Wait()
+	}
+	return nil
+}
+
+// ResetSix resets the calls made to Six. Stubs set with OnSixCall for calls
+// yet to be made keep their calls, now counted from the reset.
+func (m *MockShadow) ResetSix() {
+	m.lockSix.Lock()
+	defer m.lockSix.Unlock()
+
+	if n := len(m.calls.Six); n > 0 && m.onCalls.Six != nil {
+		onCalls := make(map[int]MockShadowSixFunc, len(m.onCalls.Six))
+		for i, fn := range m.onCalls.Six {
+			if i >= n {
+				onCalls[i-n] = fn
+			}
+		}
+		m.onCalls.Six = onCalls
+	}
+	m.calls.Six = nil
+	m.seqs.Six = nil
+	m.unstubbed.Six = 0
+}
+
+// OnSixCall makes the n'th call to Six, counting from 0 like SixCalls, call fn
+// rather than SixFunc.
+func (m *MockShadow) OnSixCall(n int, fn MockShadowSixFunc) {
+	m.lockSix.Lock()
+	defer m.lockSix.Unlock()
+
+	if m.onCalls.Six == nil {
+		m.onCalls.Six = make(map[int]MockShadowSixFunc)
+	}
+	m.onCalls.Six[n] = fn
+}
+
+// SixReturnsSequence queues fns for the next calls to Six to call in turn, one
+// per call, after any queued before, then falling back to SixFunc. Calls
+// stubbed with OnSixCall don't use up the queue.
+func (m *MockShadow) SixReturnsSequence(fns ...MockShadowSixFunc) {
+	m.lockSix.Lock()
+	defer m.lockSix.Unlock()
+
+	m.queued.Six = append(m.queued.Six, fns...)
+}
+
+// WithSix sets SixFunc to fn, taking the lock calls to Six take so it's safe
+// once the mock's shared, and returns the mock.
+func (m *MockShadow) WithSix(fn MockShadowSixFunc) *MockShadow {
+	m.lockSix.Lock()
+	m.SixFunc = fn
+	m.lockSix.Unlock()
+	return m
+}
+
+// Reset resets the calls made to the mocked methods.
+func (m *MockShadow) Reset() {
+	m.ResetFive()
+	m.ResetSix()
+}
+
+// ResetStubs clears the funcs and per-call stubs of the mocked methods.
+func (m *MockShadow) ResetStubs() {
+	m.lockFive.Lock()
+	m.FiveFunc = nil
+	m.onCalls.Five = nil
+	m.queued.Five = nil
+	m.lockFive.Unlock()
+	m.lockSix.Lock()
+	m.SixFunc = nil
+	m.onCalls.Six = nil
+	m.queued.Six = nil
+	m.lockSix.Unlock()
+}
+
+// ResetAll resets the calls made to the mocked methods and clears their
+// funcs and per-call stubs.
+func (m *MockShadow) ResetAll() {
+	m.Reset()
+	m.ResetStubs()
+}
+
+// Verify reports through t any calls made to methods without a func, funcs
+// and per-call stubs that weren't called, and unmet expectations. It returns
+// whether there were none.
+func (m *MockShadow) Verify(t testing.TB) bool {
+	t.Helper()
+	ok := true
+	m.lockFive.Lock()
+	if m.unstubbed.Five > 0 {
+		t.Errorf("mocker: MockShadow.Five was called %v times without a func", m.unstubbed.Five)
+		ok = false
+	}
+	if m.FiveFunc != nil && len(m.calls.Five) == 0 {
+		t.Errorf("mocker: MockShadow.FiveFunc is set but Five wasn't called")
+		ok = false
+	}
+	for n := range m.onCalls.Five {
+		t.Errorf("mocker: MockShadow.Five's stub for call %v wasn't called", n)
+		ok = false
+	}
+	if len(m.queued.Five) > 0 {
+		t.Errorf("mocker: MockShadow.Five's last %v stubs in sequence weren't called", len(m.queued.Five))
+		ok = false
+	}
+	m.lockFive.Unlock()
+	m.lockSix.Lock()
+	if m.unstubbed.Six > 0 {
+		t.Errorf("mocker: MockShadow.Six was called %v times without a func", m.unstubbed.Six)
+		ok = false
+	}
+	if m.SixFunc != nil && len(m.calls.Six) == 0 {
+		t.Errorf("mocker: MockShadow.SixFunc is set but Six wasn't called")
+		ok = false
+	}
+	for n := range m.onCalls.Six {
+		t.Errorf("mocker: MockShadow.Six's stub for call %v wasn't called", n)
+		ok = false
+	}
+	if len(m.queued.Six) > 0 {
+		t.Errorf("mocker: MockShadow.Six's last %v stubs in sequence weren't called", len(m.queued.Six))
+		ok = false
+	}
+	m.lockSix.Unlock()
+	return ok
+}
+
+// MockAlias is a mock of Alias interface
+type MockAlias struct {
+	lockGet sync.Mutex
+	GetFunc MockAliasGetFunc
+
+	calls struct {
+		Get []*MockAliasGetCall
+	}
+	seqs struct {
+		Get []uint64
+	}
+	conds struct {
+		Get *sync.Cond
+	}
+	onCalls struct {
+		Get map[int]MockAliasGetFunc
+	}
+	queued struct {
+		Get []MockAliasGetFunc
+	}
+	unstubbed struct {
+		Get int
+	}
+	t testing.TB
+}
+
+// MockAlias must implement Alias, so the build breaks if it's stale.
+var _ Alias = (*MockAlias)(nil)
+
+// NewMockAlias returns a MockAlias that reports calls to methods without a
+// func through t, rather than panicking, and verifies it was used as
+// stubbed when the test's done.
+func NewMockAlias(t testing.TB) *MockAlias {
+	m := &MockAlias{t: t}
+	t.Cleanup(func() {
+		m.Verify(t)
+	})
+	return m
+}
+
+// MockAliasGetFunc is the func MockAlias.Get calls.
+type MockAliasGetFunc func(n d.Int) d.Int
+
+// MockAliasGetCall is a call made to MockAlias.Get.
+type MockAliasGetCall struct {
+	N d.Int
+
+	Ret0 d.Int
+
+	// Panic is the value the call panicked with, if it did.
+	Panic interface{}
+}
+
+// Get mocks base method by wrapping the associated func.
+func (m *MockAlias) Get(n d.Int) d.Int {
+	m.lockGet.Lock()
+	fn := m.GetFunc
+	if onCall, ok := m.onCalls.Get[len(m.calls.Get)]; ok {
+		fn = onCall
+		delete(m.onCalls.Get, len(m.calls.Get))
+	} else if len(m.queued.Get) > 0 {
+		fn = m.queued.Get[0]
+		m.queued.Get = m.queued.Get[1:]
+	}
+	if fn == nil {
+		if m.t == nil {
+			m.lockGet.Unlock()
+			panic("mocker: MockAlias.GetFunc is nil but MockAlias.Get was called.")
+		}
+		m.unstubbed.Get++
+	}
+	call := &MockAliasGetCall{
+		N: n,
+	}
+	m.calls.Get = append(m.calls.Get, call)
+	m.seqs.Get = append(m.seqs.Get, mocker.Sequence())
+	if m.conds.Get != nil {
+		m.conds.Get.Broadcast()
+	}
+	m.lockGet.Unlock()
+
+	var ret0 d.Int
+	defer func() {
+		r := recover()
+		m.lockGet.Lock()
+		call.Ret0 = ret0
+		call.Panic = r
+		m.lockGet.Unlock()
+		if r != nil {
+			panic(r)
+		}
+	}()
+
+	if fn == nil {
+		return ret0
+	}
+
+	ret0 = fn(n)
+	return ret0
+}
+
+// GetCalled returns true if Get was called at least once.
+func (m *MockAlias) GetCalled() bool {
+	m.lockGet.Lock()
+	defer m.lockGet.Unlock()
+
+	return len(m.calls.Get) > 0
+}
+
+// GetCalls returns the calls made to Get.
+func (m *MockAlias) GetCalls() []MockAliasGetCall {
+	m.lockGet.Lock()
+	defer m.lockGet.Unlock()
+
+	var calls []MockAliasGetCall
+	for _, call := range m.calls.Get {
+		calls = append(calls, *call)
+	}
+	return calls
+}
+
+// GetCallCount returns the number of calls made to Get.
+func (m *MockAlias) GetCallCount() int {
+	m.lockGet.Lock()
+	defer m.lockGet.Unlock()
+
+	return len(m.calls.Get)
+}
+
+// GetCallAt returns the i'th call made to Get, counting from 0, failing the
+// test through t if there weren't that many calls.
+func (m *MockAlias) GetCallAt(t testing.TB, i int) MockAliasGetCall {
+	t.Helper()
+	m.lockGet.Lock()
+	defer m.lockGet.Unlock()
+
+	if i < 0 || i >= len(m.calls.Get) {
+		t.Fatalf("mocker: MockAlias.Get was called %v times, wanted call %v", len(m.calls.Get), i)
+	}
+	return *m.calls.Get[i]
+}
+
+// GetLastCall returns the last call made to Get, failing the test through t
+// if there weren't any.
+func (m *MockAlias) GetLastCall(t testing.TB) MockAliasGetCall {
+	t.Helper()
+	m.lockGet.Lock()
+	defer m.lockGet.Unlock()
+
+	if len(m.calls.Get) == 0 {
+		t.Fatalf("mocker: MockAlias.Get wasn't called")
+	}
+	return *m.calls.Get[len(m.calls.Get)-1]
+}
+
+// GetCallsWhere returns the calls made to Get that fn returns true for.
+func (m *MockAlias) GetCallsWhere(fn func(MockAliasGetCall) bool) []MockAliasGetCall {
+	var calls []MockAliasGetCall
+	for _, call := range m.GetCalls() {
+		if fn(call) {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// AssertGetCalledWith reports through t unless Get was called with args
+// matching the matchers, and returns whether it was.
+func (m *MockAlias) AssertGetCalledWith(t testing.TB, n match.Matcher) bool {
+	t.Helper()
+	m.lockGet.Lock()
+	defer m.lockGet.Unlock()
+
+	for _, call := range m.calls.Get {
+		if n.Match(call.N) {
+			return true
+		}
+	}
+	t.Errorf("mocker: MockAlias.Get wasn't called with (%v)", n)
+	return false
+}
+
+// GetCall describes the calls made to Get with args matching args, which
+// may be match.Matchers, for asserting on their order with mocker.InOrder.
+func (m *MockAlias) GetCall(n interface{}) mocker.Call {
+	m.lockGet.Lock()
+	defer m.lockGet.Unlock()
+
+	var seqs []uint64
+	for i, call := range m.calls.Get {
+		if match.Of(n).Match(call.N) {
+			seqs = append(seqs, m.seqs.Get[i])
+		}
+	}
+	return mocker.Call{
+		Desc: mocker.Describe("MockAlias.Get", n),
+		Seqs: seqs,
+	}
+}
+
+// WaitForGet blocks until Get has been called at least n_2 times, returning
+// nil, or until ctx is done, returning its error.
+func (m *MockAlias) WaitForGet(ctx context.Context, n_2 int) error {
+	m.lockGet.Lock()
+	defer m.lockGet.Unlock()
+
+	if m.conds.Get == nil {
+		m.conds.Get = sync.NewCond(&m.lockGet)
+	}
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		select {
+		case <-ctx.Done():
+			m.lockGet.Lock()
+			m.conds.Get.Broadcast()
+			m.lockGet.Unlock()
+		case <-stop:
+		}
+	}()
+	for len(m.calls.Get) < n_2 {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		m.conds.Get.Wait()
+	}
+	return nil
+}
+
+// ResetGet resets the calls made to Get. Stubs set with OnGetCall for calls
+// yet to be made keep their calls, now counted from the reset.
+func (m *MockAlias) ResetGet() {
+	m.lockGet.Lock()
+	defer m.lockGet.Unlock()
+
+	if n_2 := len(m.calls.Get); n_2 > 0 && m.onCalls.Get != nil {
+		onCalls := make(map[int]MockAliasGetFunc, len(m.onCalls.Get))
+		for i, fn := range m.onCalls.Get {
+			if i >= n_2 {
+				onCalls[i-n_2] = fn
+			}
+		}
+		m.onCalls.Get = onCalls
+	}
+	m.calls.Get = nil
+	m.seqs.Get = nil
+	m.unstubbed.Get = 0
+}
+
+// OnGetCall makes the n'th call to Get, counting from 0 like GetCalls, call fn
+// rather than GetFunc.
+func (m *MockAlias) OnGetCall(n int, fn MockAliasGetFunc) {
+	m.lockGet.Lock()
+	defer m.lockGet.Unlock()
+
+	if m.onCalls.Get == nil {
+		m.onCalls.Get = make(map[int]MockAliasGetFunc)
+	}
+	m.onCalls.Get[n] = fn
+}
+
+// GetReturnsSequence queues fns for the next calls to Get to call in turn, one
+// per call, after any queued before, then falling back to GetFunc. Calls
+// stubbed with OnGetCall don't use up the queue.
+func (m *MockAlias) GetReturnsSequence(fns ...MockAliasGetFunc) {
+	m.lockGet.Lock()
+	defer m.lockGet.Unlock()
+
+	m.queued.Get = append(m.queued.Get, fns...)
+}
+
+// WithGet sets GetFunc to fn, taking the lock calls to Get take so it's safe
+// once the mock's shared, and returns the mock.
+func (m *MockAlias) WithGet(fn MockAliasGetFunc) *MockAlias {
+	m.lockGet.Lock()
+	m.GetFunc = fn
+	m.lockGet.Unlock()
+	return m
+}
+
+// Reset resets the calls made to the mocked methods.
+func (m *MockAlias) Reset() {
+	m.ResetGet()
+}
+
+// ResetStubs clears the funcs and per-call stubs of the mocked methods.
+func (m *MockAlias) ResetStubs() {
+	m.lockGet.Lock()
+	m.GetFunc = nil
+	m.onCalls.Get = nil
+	m.queued.Get = nil
+	m.lockGet.Unlock()
+}
+
+// ResetAll resets the calls made to the mocked methods and clears their
+// funcs and per-call stubs.
+func (m *MockAlias) ResetAll() {
+	m.Reset()
+	m.ResetStubs()
+}
+
+// Verify reports through t any calls made to methods without a func, funcs
+// and per-call stubs that weren't called, and unmet expectations. It returns
+// whether there were none.
+func (m *MockAlias) Verify(t testing.TB) bool {
+	t.Helper()
+	ok := true
+	m.lockGet.Lock()
+	if m.unstubbed.Get > 0 {
+		t.Errorf("mocker: MockAlias.Get was called %v times without a func", m.unstubbed.Get)
+		ok = false
+	}
+	if m.GetFunc != nil && len(m.calls.Get) == 0 {
+		t.Errorf("mocker: MockAlias.GetFunc is set but Get wasn't called")
+		ok = false
+	}
+	for n := range m.onCalls.Get {
+		t.Errorf("mocker: MockAlias.Get's stub for call %v wasn't called", n)
+		ok = false
+	}
+	if len(m.queued.Get) > 0 {
+		t.Errorf("mocker: MockAlias.Get's last %v stubs in sequence weren't called", len(m.queued.Get))
+		ok = false
+	}
+	m.lockGet.Unlock()
+	return ok
+}
+
+// MockEdge is a mock of Edge interface
+type MockEdge struct {
+	lockNext sync.Mutex
+	NextFunc MockEdgeNextFunc
+
+	lockunexported sync.Mutex
+	unexportedFunc MockEdgeunexportedFunc
+
+	calls struct {
+		Next       []*MockEdgeNextCall
+		unexported []*MockEdgeunexportedCall
+	}
+	seqs struct {
+		Next       []uint64
+		unexported []uint64
+	}
+	conds struct {
+		Next       *sync.Cond
+		unexported *sync.Cond
+	}
+	onCalls struct {
+		Next       map[int]MockEdgeNextFunc
+		unexported map[int]MockEdgeunexportedFunc
+	}
+	queued struct {
+		Next       []MockEdgeNextFunc
+		unexported []MockEdgeunexportedFunc
+	}
+	unstubbed struct {
+		Next       int
+		unexported int
+	}
+	t testing.TB
+}
+
+// MockEdge must implement Edge, so the build breaks if it's stale.
+var _ Edge = (*MockEdge)(nil)
+
+// NewMockEdge returns a MockEdge that reports calls to methods without a
+// func through t, rather than panicking, and verifies it was used as
+// stubbed when the test's done.
+func NewMockEdge(t testing.TB) *MockEdge {
+	m := &MockEdge{t: t}
+	t.Cleanup(func() {
+		m.Verify(t)
+	})
+	return m
+}
+
+// MockEdgeNextFunc is the func MockEdge.Next calls.
+type MockEdgeNextFunc func() Edge
+
+// MockEdgeNextCall is a call made to MockEdge.Next.
+type MockEdgeNextCall struct {
+	Ret0 Edge
+
+	// Panic is the value the call panicked with, if it did.
+	Panic interface{}
+}
+
+// Next mocks base method by wrapping the associated func.
+func (m *MockEdge) Next() Edge {
+	m.lockNext.Lock()
+	fn := m.NextFunc
+	if onCall, ok := m.onCalls.Next[len(m.calls.Next)]; ok {
+		fn = onCall
+		delete(m.onCalls.Next, len(m.calls.Next))
+	} else if len(m.queued.Next) > 0 {
+		fn = m.queued.Next[0]
+		m.queued.Next = m.queued.Next[1:]
+	}
+	if fn == nil {
+		if m.t == nil {
+			m.lockNext.Unlock()
+			panic("mocker: MockEdge.NextFunc is nil but MockEdge.Next was called.")
+		}
+		m.unstubbed.Next++
+	}
+	call := &MockEdgeNextCall{}
+	m.calls.Next = append(m.calls.Next, call)
+	m.seqs.Next = append(m.seqs.Next, mocker.Sequence())
+	if m.conds.Next != nil {
+		m.conds.Next.Broadcast()
+	}
+	m.lockNext.Unlock()
+
+	var ret0 Edge
+	defer func() {
+		r := recover()
+		m.lockNext.Lock()
+		call.Ret0 = ret0
+		call.Panic = r
+		m.lockNext.Unlock()
+		if r != nil {
+			panic(r)
+		}
+	}()
+
+	if fn == nil {
+		return ret0
+	}
+
+	ret0 = fn()
+	return ret0
+}
+
+// NextCalled returns true if Next was called at least once.
+func (m *MockEdge) NextCalled() bool {
+	m.lockNext.Lock()
+	defer m.lockNext.Unlock()
+
+	return len(m.calls.Next) > 0
+}
+
+// NextCalls returns the calls made to Next.
+func (m *MockEdge) NextCalls() []MockEdgeNextCall {
+	m.lockNext.Lock()
+	defer m.lockNext.Unlock()
+
+	var calls []MockEdgeNextCall
+	for _, call := range m.calls.Next {
+		calls = append(calls, *call)
+	}
+	return calls
+}
+
+// NextCallCount returns the number of calls made to Next.
+func (m *MockEdge) NextCallCount() int {
+	m.lockNext.Lock()
+	defer m.lockNext.Unlock()
+
+	return len(m.calls.Next)
+}
+
+// NextCallAt returns the i'th call made to Next, counting from 0, failing the
+// test through t if there weren't that many calls.
+func (m *MockEdge) NextCallAt(t testing.TB, i int) MockEdgeNextCall {
+	t.Helper()
+	m.lockNext.Lock()
+	defer m.lockNext.Unlock()
+
+	if i < 0 || i >= len(m.calls.Next) {
+		t.Fatalf("mocker: MockEdge.Next was called %v times, wanted call %v", len(m.calls.Next), i)
+	}
+	return *m.calls.Next[i]
+}
+
+// NextLastCall returns the last call made to Next, failing the test through t
+// if there weren't any.
+func (m *MockEdge) NextLastCall(t testing.TB) MockEdgeNextCall {
+	t.Helper()
+	m.lockNext.Lock()
+	defer m.lockNext.Unlock()
+
+	if len(m.calls.Next) == 0 {
+		t.Fatalf("mocker: MockEdge.Next wasn't called")
+	}
+	return *m.calls.Next[len(m.calls.Next)-1]
+}
+
+// NextCallsWhere returns the calls made to Next that fn returns true for.
+func (m *MockEdge) NextCallsWhere(fn func(MockEdgeNextCall) bool) []MockEdgeNextCall {
+	var calls []MockEdgeNextCall
+	for _, call := range m.NextCalls() {
+		if fn(call) {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// AssertNextCalledWith reports through t unless Next was called with args
+// matching the matchers, and returns whether it was.
+func (m *MockEdge) AssertNextCalledWith(t testing.TB) bool {
+	t.Helper()
+	m.lockNext.Lock()
+	defer m.lockNext.Unlock()
+
+	if len(m.calls.Next) > 0 {
+		return true
+	}
+	t.Errorf("mocker: MockEdge.Next wasn't called")
+	return false
+}
+
+// NextCall describes the calls made to Next with args matching args, which
+// may be match.Matchers, for asserting on their order with mocker.InOrder.
+func (m *MockEdge) NextCall() mocker.Call {
+	m.lockNext.Lock()
+	defer m.lockNext.Unlock()
+
+	var seqs []uint64
+	seqs = append(seqs, m.seqs.Next...)
+	return mocker.Call{
+		Desc: mocker.Describe("MockEdge.Next"),
+		Seqs: seqs,
+	}
+}
+
+// WaitForNext blocks until Next has been called at least n times, returning
+// nil, or until ctx is done, returning its error.
+func (m *MockEdge) WaitForNext(ctx context.Context, n int) error {
+	m.lockNext.Lock()
+	defer m.lockNext.Unlock()
+
+	if m.conds.Next == nil {
+		m.conds.Next = sync.NewCond(&m.lockNext)
+	}
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		select {
+		case <-ctx.Done():
+			m.lockNext.Lock()
+			m.conds.Next.Broadcast()
+			m.lockNext.Unlock()
+		case <-stop:
+		}
+	}()
+	for len(m.calls.Next) < n {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		m.conds.Next.Wait()
+	}
+	return nil
+}
+
+// ResetNext resets the calls made to Next. Stubs set with OnNextCall for calls
+// yet to be made keep their calls, now counted from the reset.
+func (m *MockEdge) ResetNext() {
+	m.lockNext.Lock()
+	defer m.lockNext.Unlock()
+
+	if n := len(m.calls.Next); n > 0 && m.onCalls.Next != nil {
+		onCalls := make(map[int]MockEdgeNextFunc, len(m.onCalls.Next))
+		for i, fn := range m.onCalls.Next {
+			if i >= n {
+				onCalls[i-n] = fn
+			}
+		}
+		m.onCalls.Next = onCalls
+	}
+	m.calls.Next = nil
+	m.seqs.Next = nil
+	m.unstubbed.Next = 0
+}
+
+// OnNextCall makes the n'th call to Next, counting from 0 like NextCalls, call fn
+// rather than NextFunc.
+func (m *MockEdge) OnNextCall(n int, fn MockEdgeNextFunc) {
+	m.lockNext.Lock()
+	defer m.lockNext.Unlock()
+
+	if m.onCalls.Next == nil {
+		m.onCalls.Next = make(map[int]MockEdgeNextFunc)
+	}
+	m.onCalls.Next[n] = fn
+}
+
+// NextReturnsSequence queues fns for the next calls to Next to call in turn, one
+// per call, after any queued before, then falling back to NextFunc. Calls
+// stubbed with OnNextCall don't use up the queue.
+func (m *MockEdge) NextReturnsSequence(fns ...MockEdgeNextFunc) {
+	m.lockNext.Lock()
+	defer m.lockNext.Unlock()
+
+	m.queued.Next = append(m.queued.Next, fns...)
+}
+
+// WithNext sets NextFunc to fn, taking the lock calls to Next take so it's safe
+// once the mock's shared, and returns the mock.
+func (m *MockEdge) WithNext(fn MockEdgeNextFunc) *MockEdge {
+	m.lockNext.Lock()
+	m.NextFunc = fn
+	m.lockNext.Unlock()
+	return m
+}
+
+// MockEdgeunexportedFunc is the func MockEdge.unexported calls.
+type MockEdgeunexportedFunc func()
+
+// MockEdgeunexportedCall is a call made to MockEdge.unexported.
+type MockEdgeunexportedCall struct {
+	// Panic is the value the call panicked with, if it did.
+	Panic interface{}
+}
+
+// unexported mocks base method by wrapping the associated func.
+func (m *MockEdge) unexported() {
+	m.lockunexported.Lock()
+	fn := m.unexportedFunc
+	if onCall, ok := m.onCalls.unexported[len(m.calls.unexported)]; ok {
+		fn = onCall
+		delete(m.onCalls.unexported, len(m.calls.unexported))
+	} else if len(m.queued.unexported) > 0 {
+		fn = m.queued.unexported[0]
+		m.queued.unexported = m.queued.unexported[1:]
+	}
+	if fn == nil {
+		if m.t == nil {
+			m.lockunexported.Unlock()
+			panic("mocker: MockEdge.unexportedFunc is nil but MockEdge.unexported was called.")
+		}
+		m.unstubbed.unexported++
+	}
+	call := &MockEdgeunexportedCall{}
+	m.calls.unexported = append(m.calls.unexported, call)
+	m.seqs.unexported = append(m.seqs.unexported, mocker.Sequence())
+	if m.conds.unexported != nil {
+		m.conds.unexported.Broadcast()
+	}
+	m.lockunexported.Unlock()
+
+	defer func() {
+		r := recover()
+		m.lockunexported.Lock()
+		call.Panic = r
+		m.lockunexported.Unlock()
+		if r != nil {
+			panic(r)
+		}
+	}()
+
+	if fn == nil {
+		return
+	}
+
+	fn()
+}
+
+// unexportedCalled returns true if unexported was called at least once.
+func (m *MockEdge) unexportedCalled() bool {
+	m.lockunexported.Lock()
+	defer m.lockunexported.Unlock()
+
+	return len(m.calls.unexported) > 0
+}
+
+// unexportedCalls returns the calls made to unexported.
+func (m *MockEdge) unexportedCalls() []MockEdgeunexportedCall {
+	m.lockunexported.Lock()
+	defer m.lockunexported.Unlock()
+
+	var calls []MockEdgeunexportedCall
+	for _, call := range m.calls.unexported {
+		calls = append(calls, *call)
+	}
+	return calls
+}
+
+// unexportedCallCount returns the number of calls made to unexported.
+func (m *MockEdge) unexportedCallCount() int {
+	m.lockunexported.Lock()
+	defer m.lockunexported.Unlock()
+
+	return len(m.calls.unexported)
+}
+
+// unexportedCallAt returns the i'th call made to unexported, counting from 0, failing the
+// test through t if there weren't that many calls.
+func (m *MockEdge) unexportedCallAt(t testing.TB, i int) MockEdgeunexportedCall {
+	t.Helper()
+	m.lockunexported.Lock()
+	defer m.lockunexported.Unlock()
+
+	if i < 0 || i >= len(m.calls.unexported) {
+		t.Fatalf("mocker: MockEdge.unexported was called %v times, wanted call %v", len(m.calls.unexported), i)
+	}
+	return *m.calls.unexported[i]
+}
+
+// unexportedLastCall returns the last call made to unexported, failing the test through t
+// if there weren't any.
+func (m *MockEdge) unexportedLastCall(t testing.TB) MockEdgeunexportedCall {
+	t.Helper()
+	m.lockunexported.Lock()
+	defer m.lockunexported.Unlock()
+
+	if len(m.calls.unexported) == 0 {
+		t.Fatalf("mocker: MockEdge.unexported wasn't called")
+	}
+	return *m.calls.unexported[len(m.calls.unexported)-1]
+}
+
+// unexportedCallsWhere returns the calls made to unexported that fn returns true for.
+func (m *MockEdge) unexportedCallsWhere(fn func(MockEdgeunexportedCall) bool) []MockEdgeunexportedCall {
+	var calls []MockEdgeunexportedCall
+	for _, call := range m.unexportedCalls() {
+		if fn(call) {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// AssertunexportedCalledWith reports through t unless unexported was called with args
+// matching the matchers, and returns whether it was.
+func (m *MockEdge) AssertunexportedCalledWith(t testing.TB) bool {
+	t.Helper()
+	m.lockunexported.Lock()
+	defer m.lockunexported.Unlock()
+
+	if len(m.calls.unexported) > 0 {
+		return true
+	}
+	t.Errorf("mocker: MockEdge.unexported wasn't called")
+	return false
+}
+
+// unexportedCall describes the calls made to unexported with args matching args, which
+// may be match.Matchers, for asserting on their order with mocker.InOrder.
+func (m *MockEdge) unexportedCall() mocker.Call {
+	m.lockunexported.Lock()
+	defer m.lockunexported.Unlock()
+
+	var seqs []uint64
+	seqs = append(seqs, m.seqs.unexported...)
+	return mocker.Call{
+		Desc: mocker.Describe("MockEdge.unexported"),
+		Seqs: seqs,
+	}
+}
+
+// WaitForunexported blocks until unexported has been called at least n times, returning
+// nil, or until ctx is done, returning its error.
+func (m *MockEdge) WaitForunexported(ctx context.Context, n int) error {
+	m.lockunexported.Lock()
+	defer m.lockunexported.Unlock()
+
+	if m.conds.unexported == nil {
+		m.conds.unexported = sync.NewCond(&m.lockunexported)
+	}
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		select {
+		case <-ctx.Done():
+			m.lockunexported.Lock()
+			m.conds.unexported.Broadcast()
+			m.lockunexported.Unlock()
+		case <-stop:
+		}
+	}()
+	for len(m.calls.unexported) < n {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		m.conds.unexported.Wait()
+	}
+	return nil
+}
+
+// Resetunexported resets the calls made to unexported. Stubs set with OnunexportedCall for calls
+// yet to be made keep their calls, now counted from the reset.
+func (m *MockEdge) Resetunexported() {
+	m.lockunexported.Lock()
+	defer m.lockunexported.Unlock()
+
+	if n := len(m.calls.unexported); n > 0 && m.onCalls.unexported != nil {
+		onCalls := make(map[int]MockEdgeunexportedFunc, len(m.onCalls.unexported))
+		for i, fn := range m.onCalls.unexported {
+			if i >= n {
+				onCalls[i-n] = fn
+			}
+		}
+		m.onCalls.unexported = onCalls
+	}
+	m.calls.unexported = nil
+	m.seqs.unexported = nil
+	m.unstubbed.unexported = 0
+}
+
+// OnunexportedCall makes the n'th call to unexported, counting from 0 like unexportedCalls, call fn
+// rather than unexportedFunc.
+func (m *MockEdge) OnunexportedCall(n int, fn MockEdgeunexportedFunc) {
+	m.lockunexported.Lock()
+	defer m.lockunexported.Unlock()
+
+	if m.onCalls.unexported == nil {
+		m.onCalls.unexported = make(map[int]MockEdgeunexportedFunc)
+	}
+	m.onCalls.unexported[n] = fn
+}
+
+// unexportedReturnsSequence queues fns for the next calls to unexported to call in turn, one
+// per call, after any queued before, then falling back to unexportedFunc. Calls
+// stubbed with OnunexportedCall don't use up the queue.
+func (m *MockEdge) unexportedReturnsSequence(fns ...MockEdgeunexportedFunc) {
+	m.lockunexported.Lock()
+	defer m.lockunexported.Unlock()
+
+	m.queued.unexported = append(m.queued.unexported, fns...)
+}
+
+// Withunexported sets unexportedFunc to fn, taking the lock calls to unexported take so it's safe
+// once the mock's shared, and returns the mock.
+func (m *MockEdge) Withunexported(fn MockEdgeunexportedFunc) *MockEdge {
+	m.lockunexported.Lock()
+	m.unexportedFunc = fn
+	m.lockunexported.Unlock()
+	return m
+}
+
+// Reset resets the calls made to the mocked methods.
+func (m *MockEdge) Reset() {
+	m.ResetNext()
+	m.Resetunexported()
+}
+
+// ResetStubs clears the funcs and per-call stubs of the mocked methods.
+func (m *MockEdge) ResetStubs() {
+	m.lockNext.Lock()
+	m.NextFunc = nil
+	m.onCalls.Next = nil
+	m.queued.Next = nil
+	m.lockNext.Unlock()
+	m.lockunexported.Lock()
+	m.unexportedFunc = nil
+	m.onCalls.unexported = nil
+	m.queued.unexported = nil
+	m.lockunexported.Unlock()
+}
+
+// ResetAll resets the calls made to the mocked methods and clears their
+// funcs and per-call stubs.
+func (m *MockEdge) ResetAll() {
+	m.Reset()
+	m.ResetStubs()
+}
+
+// Verify reports through t any calls made to methods without a func, funcs
+// and per-call stubs that weren't called, and unmet expectations. It returns
+// whether there were none.
+func (m *MockEdge) Verify(t testing.TB) bool {
+	t.Helper()
+	ok := true
+	m.lockNext.Lock()
+	if m.unstubbed.Next > 0 {
+		t.Errorf("mocker: MockEdge.Next was called %v times without a func", m.unstubbed.Next)
+		ok = false
+	}
+	if m.NextFunc != nil && len(m.calls.Next) == 0 {
+		t.Errorf("mocker: MockEdge.NextFunc is set but Next wasn't called")
+		ok = false
+	}
+	for n := range m.onCalls.Next {
+		t.Errorf("mocker: MockEdge.Next's stub for call %v wasn't called", n)
+		ok = false
+	}
+	if len(m.queued.Next) > 0 {
+		t.Errorf("mocker: MockEdge.Next's last %v stubs in sequence weren't called", len(m.queued.Next))
+		ok = false
+	}
+	m.lockNext.Unlock()
+	m.lockunexported.Lock()
+	if m.unstubbed.unexported > 0 {
+		t.Errorf("mocker: MockEdge.unexported was called %v times without a func", m.unstubbed.unexported)
+		ok = false
+	}
+	if m.unexportedFunc != nil && len(m.calls.unexported) == 0 {
+		t.Errorf("mocker: MockEdge.unexportedFunc is set but unexported wasn't called")
+		ok = false
+	}
+	for n := range m.onCalls.unexported {
+		t.Errorf("mocker: MockEdge.unexported's stub for call %v wasn't called", n)
+		ok = false
+	}
+	if len(m.queued.unexported) > 0 {
+		t.Errorf("mocker: MockEdge.unexported's last %v stubs in sequence weren't called", len(m.queued.unexported))
+		ok = false
+	}
+	m.lockunexported.Unlock()
+	return ok
+}
+
+// MockClash is a mock of Clash interface
+type MockClash struct {
+	lockVerify sync.Mutex
+	VerifyFunc MockClashVerifyFunc
+
+	lockClose sync.Mutex
+	CloseFunc MockClashCloseFunc
+
+	lockKeys sync.Mutex
+	KeysFunc MockClashKeysFunc
+
+	lockDo sync.Mutex
+	DoFunc MockClashDoFunc
+
+	lockDoCall sync.Mutex
+	DoCallFunc MockClashDoCallFunc
+
+	lockAll sync.Mutex
+	AllFunc MockClashAllFunc
+
+	lockStubs sync.Mutex
+	StubsFunc MockClashStubsFunc
+
+	lockFoo sync.Mutex
+	FooFunc MockClashFooFunc
+
+	lockResetFoo sync.Mutex
+	ResetFooFunc MockClashResetFooFunc
+
+	lockWait sync.Mutex
+	WaitFunc MockClashWaitFunc
+
+	lockWaitForWait sync.Mutex
+	WaitForWaitFunc MockClashWaitForWaitFunc
+
+	lockSubscribe sync.Mutex
+	SubscribeFunc MockClashSubscribeFunc
+
+	calls struct {
+		Verify      []*MockClashVerifyCall
+		Close       []*MockClashCloseCall
+		Keys        []*MockClashKeysCall
+		Do          []*MockClashDoCall
+		DoCall      []*MockClashDoCallCall
+		All         []*MockClashAllCall
+		Stubs       []*MockClashStubsCall
+		Foo         []*MockClashFooCall
+		ResetFoo    []*MockClashResetFooCall
+		Wait        []*MockClashWaitCall
+		WaitForWait []*MockClashWaitForWaitCall
+		Subscribe   []*MockClashSubscribeCall
+	}
+	seqs struct {
+		Verify      []uint64
+		Close       []uint64
+		Keys        []uint64
+		Do          []uint64
+		DoCall      []uint64
+		All         []uint64
+		Stubs       []uint64
+		Foo         []uint64
+		ResetFoo    []uint64
+		Wait        []uint64
+		WaitForWait []uint64
+		Subscribe   []uint64
+	}
+	conds struct {
+		Verify      *sync.Cond
+		Close       *sync.Cond
+		Keys        *sync.Cond
+		Do          *sync.Cond
+		DoCall      *sync.Cond
+		All         *sync.Cond
+		Stubs       *sync.Cond
+		Foo         *sync.Cond
+		ResetFoo    *sync.Cond
+		Wait        *sync.Cond
+		WaitForWait *sync.Cond
+		Subscribe   *sync.Cond
+	}
+	onCalls struct {
+		Verify      map[int]MockClashVerifyFunc
+		Close       map[int]MockClashCloseFunc
+		Keys        map[int]MockClashKeysFunc
+		Do          map[int]MockClashDoFunc
+		DoCall      map[int]MockClashDoCallFunc
+		All         map[int]MockClashAllFunc
+		Stubs       map[int]MockClashStubsFunc
+		Foo         map[int]MockClashFooFunc
+		ResetFoo    map[int]MockClashResetFooFunc
+		Wait        map[int]MockClashWaitFunc
+		WaitForWait map[int]MockClashWaitForWaitFunc
+		Subscribe   map[int]MockClashSubscribeFunc
+	}
+	queued struct {
+		Verify      []MockClashVerifyFunc
+		Close       []MockClashCloseFunc
+		Keys        []MockClashKeysFunc
+		Do          []MockClashDoFunc
+		DoCall      []MockClashDoCallFunc
+		All         []MockClashAllFunc
+		Stubs       []MockClashStubsFunc
+		Foo         []MockClashFooFunc
+		ResetFoo    []MockClashResetFooFunc
+		Wait        []MockClashWaitFunc
+		WaitForWait []MockClashWaitForWaitFunc
+		Subscribe   []MockClashSubscribeFunc
+	}
+	unstubbed struct {
+		Verify      int
+		Close       int
+		Keys        int
+		Do          int
+		DoCall      int
+		All         int
+		Stubs       int
+		Foo         int
+		ResetFoo    int
+		Wait        int
+		WaitForWait int
+		Subscribe   int
+	}
+	t testing.TB
+}
+
+// MockClash must implement Clash, so the build breaks if it's stale.
+var _ Clash = (*MockClash)(nil)
+
+// NewMockClash returns a MockClash that reports calls to methods without a
+// func through t, rather than panicking, and verifies it was used as
+// stubbed when the test's done.
+func NewMockClash(t testing.TB) *MockClash {
+	m := &MockClash{t: t}
+	t.Cleanup(func() {
+		m.Verify_2(t)
+	})
+	return m
+}
+
+// MockClashVerifyFunc is the func MockClash.Verify calls.
+type MockClashVerifyFunc func(token string) error
+
+// MockClashVerifyCall is a call made to MockClash.Verify.
+type MockClashVerifyCall struct {
+	Token string
+
+	Ret0 error
+
+	// Panic is the value the call panicked with, if it did.
+	Panic interface{}
+}
+
+// Verify mocks base method by wrapping the associated func.
+func (m *MockClash) Verify(token string) error {
+	m.lockVerify.Lock()
+	fn := m.VerifyFunc
+	if onCall, ok := m.onCalls.Verify[len(m.calls.Verify)]; ok {
+		fn = onCall
+		delete(m.onCalls.Verify, len(m.calls.Verify))
+	} else if len(m.queued.Verify) > 0 {
+		fn = m.queued.Verify[0]
+		m.queued.Verify = m.queued.Verify[1:]
+	}
+	if fn == nil {
+		if m.t == nil {
+			m.lockVerify.Unlock()
+			panic("mocker: MockClash.VerifyFunc is nil but MockClash.Verify was called.")
+		}
+		m.unstubbed.Verify++
+	}
+	call := &MockClashVerifyCall{
+		Token: token,
+	}
+	m.calls.Verify = append(m.calls.Verify, call)
+	m.seqs.Verify = append(m.seqs.Verify, mocker.Sequence())
+	if m.conds.Verify != nil {
+		m.conds.Verify.Broadcast()
+	}
+	m.lockVerify.Unlock()
+
+	var ret0 error
+	defer func() {
+		r := recover()
+		m.lockVerify.Lock()
+		call.Ret0 = ret0
+		call.Panic = r
+		m.lockVerify.Unlock()
+		if r != nil {
+			panic(r)
+		}
+	}()
+
+	if fn == nil {
+		return ret0
+	}
+
+	ret0 = fn(token)
+	return ret0
+}
+
+// VerifyCalled returns true if Verify was called at least once.
+func (m *MockClash) VerifyCalled() bool {
+	m.lockVerify.Lock()
+	defer m.lockVerify.Unlock()
+
+	return len(m.calls.Verify) > 0
+}
+
+// VerifyCalls returns the calls made to Verify.
+func (m *MockClash) VerifyCalls() []MockClashVerifyCall {
+	m.lockVerify.Lock()
+	defer m.lockVerify.Unlock()
+
+	var calls []MockClashVerifyCall
+	for _, call := range m.calls.Verify {
+		calls = append(calls, *call)
+	}
+	return calls
+}
+
+// VerifyCallCount returns the number of calls made to Verify.
+func (m *MockClash) VerifyCallCount() int {
+	m.lockVerify.Lock()
+	defer m.lockVerify.Unlock()
+
+	return len(m.calls.Verify)
+}
+
+// VerifyCallAt returns the i'th call made to Verify, counting from 0, failing the
+// test through t if there weren't that many calls.
+func (m *MockClash) VerifyCallAt(t testing.TB, i int) MockClashVerifyCall {
+	t.Helper()
+	m.lockVerify.Lock()
+	defer m.lockVerify.Unlock()
+
+	if i < 0 || i >= len(m.calls.Verify) {
+		t.Fatalf("mocker: MockClash.Verify was called %v times, wanted call %v", len(m.calls.Verify), i)
+	}
+	return *m.calls.Verify[i]
+}
+
+// VerifyLastCall returns the last call made to Verify, failing the test through t
+// if there weren't any.
+func (m *MockClash) VerifyLastCall(t testing.TB) MockClashVerifyCall {
+	t.Helper()
+	m.lockVerify.Lock()
+	defer m.lockVerify.Unlock()
+
+	if len(m.calls.Verify) == 0 {
+		t.Fatalf("mocker: MockClash.Verify wasn't called")
+	}
+	return *m.calls.Verify[len(m.calls.Verify)-1]
+}
+
+// VerifyCallsWhere returns the calls made to Verify that fn returns true for.
+func (m *MockClash) VerifyCallsWhere(fn func(MockClashVerifyCall) bool) []MockClashVerifyCall {
+	var calls []MockClashVerifyCall
+	for _, call := range m.VerifyCalls() {
+		if fn(call) {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// AssertVerifyCalledWith reports through t unless Verify was called with args
+// matching the matchers, and returns whether it was.
+func (m *MockClash) AssertVerifyCalledWith(t testing.TB, token match.Matcher) bool {
+	t.Helper()
+	m.lockVerify.Lock()
+	defer m.lockVerify.Unlock()
+
+	for _, call := range m.calls.Verify {
+		if token.Match(call.Token) {
+			return true
+		}
+	}
+	t.Errorf("mocker: MockClash.Verify wasn't called with (%v)", token)
+	return false
+}
+
+// VerifyCall describes the calls made to Verify with args matching args, which
+// may be match.Matchers, for asserting on their order with mocker.InOrder.
+func (m *MockClash) VerifyCall(token interface{}) mocker.Call {
+	m.lockVerify.Lock()
+	defer m.lockVerify.Unlock()
+
+	var seqs []uint64
+	for i, call := range m.calls.Verify {
+		if match.Of(token).Match(call.Token) {
+			seqs = append(seqs, m.seqs.Verify[i])
+		}
+	}
+	return mocker.Call{
+		Desc: mocker.Describe("MockClash.Verify", token),
+		Seqs: seqs,
+	}
+}
+
+// WaitForVerify blocks until Verify has been called at least n times, returning
+// nil, or until ctx is done, returning its error.
+func (m *MockClash) WaitForVerify(ctx context.Context, n int) error {
+	m.lockVerify.Lock()
+	defer m.lockVerify.Unlock()
+
+	if m.conds.Verify == nil {
+		m.conds.Verify = sync.NewCond(&m.lockVerify)
+	}
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		select {
+		case <-ctx.Done():
+			m.lockVerify.Lock()
+			m.conds.Verify.Broadcast()
+			m.lockVerify.Unlock()
+		case <-stop:
+		}
+	}()
+	for len(m.calls.Verify) < n {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		m.conds.Verify.Wait()
+	}
+	return nil
+}
+
+// ResetVerify resets the calls made to Verify. Stubs set with OnVerifyCall for calls
+// yet to be made keep their calls, now counted from the reset.
+func (m *MockClash) ResetVerify() {
+	m.lockVerify.Lock()
+	defer m.lockVerify.Unlock()
+
+	if n := len(m.calls.Verify); n > 0 && m.onCalls.Verify != nil {
+		onCalls := make(map[int]MockClashVerifyFunc, len(m.onCalls.Verify))
+		for i, fn := range m.onCalls.Verify {
+			if i >= n {
+				onCalls[i-n] = fn
+			}
+		}
+		m.onCalls.Verify = onCalls
+	}
+	m.calls.Verify = nil
+	m.seqs.Verify = nil
+	m.unstubbed.Verify = 0
+}
+
+// OnVerifyCall makes the n'th call to Verify, counting from 0 like VerifyCalls, call fn
+// rather than VerifyFunc.
+func (m *MockClash) OnVerifyCall(n int, fn MockClashVerifyFunc) {
+	m.lockVerify.Lock()
+	defer m.lockVerify.Unlock()
+
+	if m.onCalls.Verify == nil {
+		m.onCalls.Verify = make(map[int]MockClashVerifyFunc)
+	}
+	m.onCalls.Verify[n] = fn
+}
+
+// VerifyReturnsSequence queues fns for the next calls to Verify to call in turn, one
+// per call, after any queued before, then falling back to VerifyFunc. Calls
+// stubbed with OnVerifyCall don't use up the queue.
+func (m *MockClash) VerifyReturnsSequence(fns ...MockClashVerifyFunc) {
+	m.lockVerify.Lock()
+	defer m.lockVerify.Unlock()
+
+	m.queued.Verify = append(m.queued.Verify, fns...)
+}
+
+// WithVerify sets VerifyFunc to fn, taking the lock calls to Verify take so it's safe
+// once the mock's shared, and returns the mock.
+func (m *MockClash) WithVerify(fn MockClashVerifyFunc) *MockClash {
+	m.lockVerify.Lock()
+	m.VerifyFunc = fn
+	m.lockVerify.Unlock()
+	return m
+}
+
+// MockClashCloseFunc is the func MockClash.Close calls.
+type MockClashCloseFunc func() error
+
+// MockClashCloseCall is a call made to MockClash.Close.
+type MockClashCloseCall struct {
+	Ret0 error
+
+	// Panic is the value the call panicked with, if it did.
+	Panic interface{}
+}
+
+// Close mocks base method by wrapping the associated func.
+func (m *MockClash) Close() error {
+	m.lockClose.Lock()
+	fn := m.CloseFunc
+	if onCall, ok := m.onCalls.Close[len(m.calls.Close)]; ok {
+		fn = onCall
+		delete(m.onCalls.Close, len(m.calls.Close))
+	} else if len(m.queued.Close) > 0 {
+		fn = m.queued.Close[0]
+		m.queued.Close = m.queued.Close[1:]
+	}
+	if fn == nil {
+		if m.t == nil {
+			m.lockClose.Unlock()
+			panic("mocker: MockClash.CloseFunc is nil but MockClash.Close was called.")
+		}
+		m.unstubbed.Close++
+	}
+	call := &MockClashCloseCall{}
+	m.calls.Close = append(m.calls.Close, call)
+	m.seqs.Close = append(m.seqs.Close, mocker.Sequence())
+	if m.conds.Close != nil {
+		m.conds.Close.Broadcast()
+	}
+	m.lockClose.Unlock()
+
+	var ret0 error
+	defer func() {
+		r := recover()
+		m.lockClose.Lock()
+		call.Ret0 = ret0
+		call.Panic = r
+		m.lockClose.Unlock()
+		if r != nil {
+			panic(r)
+		}
+	}()
+
+	if fn == nil {
+		return ret0
+	}
+
+	ret0 = fn()
+	return ret0
+}
+
+// CloseCalled returns true if Close was called at least once.
+func (m *MockClash) CloseCalled() bool {
+	m.lockClose.Lock()
+	defer m.lockClose.Unlock()
+
+	return len(m.calls.Close) > 0
+}
+
+// CloseCalls returns the calls made to Close.
+func (m *MockClash) CloseCalls() []MockClashCloseCall {
+	m.lockClose.Lock()
+	defer m.lockClose.Unlock()
+
+	var calls []MockClashCloseCall
+	for _, call := range m.calls.Close {
+		calls = append(calls, *call)
+	}
+	return calls
+}
+
+// CloseCallCount returns the number of calls made to Close.
+func (m *MockClash) CloseCallCount() int {
+	m.lockClose.Lock()
+	defer m.lockClose.Unlock()
+
+	return len(m.calls.Close)
+}
+
+// CloseCallAt returns the i'th call made to Close, counting from 0, failing the
+// test through t if there weren't that many calls.
+func (m *MockClash) CloseCallAt(t testing.TB, i int) MockClashCloseCall {
+	t.Helper()
+	m.lockClose.Lock()
+	defer m.lockClose.Unlock()
+
+	if i < 0 || i >= len(m.calls.Close) {
+		t.Fatalf("mocker: MockClash.Close was called %v times, wanted call %v", len(m.calls.Close), i)
+	}
+	return *m.calls.Close[i]
+}
+
+// CloseLastCall returns the last call made to Close, failing the test through t
+// if there weren't any.
+func (m *MockClash) CloseLastCall(t testing.TB) MockClashCloseCall {
+	t.Helper()
+	m.lockClose.Lock()
+	defer m.lockClose.Unlock()
+
+	if len(m.calls.Close) == 0 {
+		t.Fatalf("mocker: MockClash.Close wasn't called")
+	}
+	return *m.calls.Close[len(m.calls.Close)-1]
+}
+
+// CloseCallsWhere returns the calls made to Close that fn returns true for.
+func (m *MockClash) CloseCallsWhere(fn func(MockClashCloseCall) bool) []MockClashCloseCall {
+	var calls []MockClashCloseCall
+	for _, call := range m.CloseCalls() {
+		if fn(call) {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// AssertCloseCalledWith reports through t unless Close was called with args
+// matching the matchers, and returns whether it was.
+func (m *MockClash) AssertCloseCalledWith(t testing.TB) bool {
+	t.Helper()
+	m.lockClose.Lock()
+	defer m.lockClose.Unlock()
+
+	if len(m.calls.Close) > 0 {
+		return true
+	}
+	t.Errorf("mocker: MockClash.Close wasn't called")
+	return false
+}
+
+// CloseCall describes the calls made to Close with args matching args, which
+// may be match.Matchers, for asserting on their order with mocker.InOrder.
+func (m *MockClash) CloseCall() mocker.Call {
+	m.lockClose.Lock()
+	defer m.lockClose.Unlock()
+
+	var seqs []uint64
+	seqs = append(seqs, m.seqs.Close...)
+	return mocker.Call{
+		Desc: mocker.Describe("MockClash.Close"),
+		Seqs: seqs,
+	}
+}
+
+// WaitForClose blocks until Close has been called at least n times, returning
+// nil, or until ctx is done, returning its error.
+func (m *MockClash) WaitForClose(ctx context.Context, n int) error {
+	m.lockClose.Lock()
+	defer m.lockClose.Unlock()
+
+	if m.conds.Close == nil {
+		m.conds.Close = sync.NewCond(&m.lockClose)
+	}
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		select {
+		case <-ctx.Done():
+			m.lockClose.Lock()
+			m.conds.Close.Broadcast()
+			m.lockClose.Unlock()
+		case <-stop:
+		}
+	}()
+	for len(m.calls.Close) < n {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		m.conds.Close.Wait()
+	}
+	return nil
+}
+
+// ResetClose resets the calls made to Close. Stubs set with OnCloseCall for calls
+// yet to be made keep their calls, now counted from the reset.
+func (m *MockClash) ResetClose() {
+	m.lockClose.Lock()
+	defer m.lockClose.Unlock()
+
+	if n := len(m.calls.Close); n > 0 && m.onCalls.Close != nil {
+		onCalls := make(map[int]MockClashCloseFunc, len(m.onCalls.Close))
+		for i, fn := range m.onCalls.Close {
+			if i >= n {
+				onCalls[i-n] = fn
+			}
+		}
+		m.onCalls.Close = onCalls
+	}
+	m.calls.Close = nil
+	m.seqs.Close = nil
+	m.unstubbed.Close = 0
+}
+
+// OnCloseCall makes the n'th call to Close, counting from 0 like CloseCalls, call fn
+// rather than CloseFunc.
+func (m *MockClash) OnCloseCall(n int, fn MockClashCloseFunc) {
+	m.lockClose.Lock()
+	defer m.lockClose.Unlock()
+
+	if m.onCalls.Close == nil {
+		m.onCalls.Close = make(map[int]MockClashCloseFunc)
+	}
+	m.onCalls.Close[n] = fn
+}
+
+// CloseReturnsSequence queues fns for the next calls to Close to call in turn, one
+// per call, after any queued before, then falling back to CloseFunc. Calls
+// stubbed with OnCloseCall don't use up the queue.
+func (m *MockClash) CloseReturnsSequence(fns ...MockClashCloseFunc) {
+	m.lockClose.Lock()
+	defer m.lockClose.Unlock()
+
+	m.queued.Close = append(m.queued.Close, fns...)
+}
+
+// WithClose sets CloseFunc to fn, taking the lock calls to Close take so it's safe
+// once the mock's shared, and returns the mock.
+func (m *MockClash) WithClose(fn MockClashCloseFunc) *MockClash {
+	m.lockClose.Lock()
+	m.CloseFunc = fn
+	m.lockClose.Unlock()
+	return m
+}
+
+// MockClashKeysFunc is the func MockClash.Keys calls.
+type MockClashKeysFunc func() []string
+
+// MockClashKeysCall is a call made to MockClash.Keys.
+type MockClashKeysCall struct {
+	Ret0 []string
+
+	// Panic is the value the call panicked with, if it did.
+	Panic interface{}
+}
+
+// Keys mocks base method by wrapping the associated func.
+func (m *MockClash) Keys() []string {
+	m.lockKeys.Lock()
+	fn := m.KeysFunc
+	if onCall, ok := m.onCalls.Keys[len(m.calls.Keys)]; ok {
+		fn = onCall
+		delete(m.onCalls.Keys, len(m.calls.Keys))
+	} else if len(m.queued.Keys) > 0 {
+		fn = m.queued.Keys[0]
+		m.queued.Keys = m.queued.Keys[1:]
+	}
+	if fn == nil {
+		if m.t == nil {
+			m.lockKeys.Unlock()
+			panic("mocker: MockClash.KeysFunc is nil but MockClash.Keys was called.")
+		}
+		m.unstubbed.Keys++
+	}
+	call := &MockClashKeysCall{}
+	m.calls.Keys = append(m.calls.Keys, call)
+	m.seqs.Keys = append(m.seqs.Keys, mocker.Sequence())
+	if m.conds.Keys != nil {
+		m.conds.Keys.Broadcast()
+	}
+	m.lockKeys.Unlock()
+
+	var ret0 []string
+	defer func() {
+		r := recover()
+		m.lockKeys.Lock()
+		call.Ret0 = ret0
+		call.Panic = r
+		m.lockKeys.Unlock()
+		if r != nil {
+			panic(r)
+		}
+	}()
+
+	if fn == nil {
+		return ret0
+	}
+
+	ret0 = fn()
+	return ret0
+}
+
+// KeysCalled returns true if Keys was called at least once.
+func (m *MockClash) KeysCalled() bool {
+	m.lockKeys.Lock()
+	defer m.lockKeys.Unlock()
+
+	return len(m.calls.Keys) > 0
+}
+
+// KeysCalls returns the calls made to Keys.
+func (m *MockClash) KeysCalls() []MockClashKeysCall {
+	m.lockKeys.Lock()
+	defer m.lockKeys.Unlock()
+
+	var calls []MockClashKeysCall
+	for _, call := range m.calls.Keys {
+		calls = append(calls, *call)
+	}
+	return calls
+}
+
+// KeysCallCount returns the number of calls made to Keys.
+func (m *MockClash) KeysCallCount() int {
+	m.lockKeys.Lock()
+	defer m.lockKeys.Unlock()
+
+	return len(m.calls.Keys)
+}
+
+// KeysCallAt returns the i'th call made to Keys, counting from 0, failing the
+// test through t if there weren't that many calls.
+func (m *MockClash) KeysCallAt(t testing.TB, i int) MockClashKeysCall {
+	t.Helper()
+	m.lockKeys.Lock()
+	defer m.lockKeys.Unlock()
+
+	if i < 0 || i >= len(m.calls.Keys) {
+		t.Fatalf("mocker: MockClash.Keys was called %v times, wanted call %v", len(m.calls.Keys), i)
+	}
+	return *m.calls.Keys[i]
+}
+
+// KeysLastCall returns the last call made to Keys, failing the test through t
+// if there weren't any.
+func (m *MockClash) KeysLastCall(t testing.TB) MockClashKeysCall {
+	t.Helper()
+	m.lockKeys.Lock()
+	defer m.lockKeys.Unlock()
+
+	if len(m.calls.Keys) == 0 {
+		t.Fatalf("mocker: MockClash.Keys wasn't called")
+	}
+	return *m.calls.Keys[len(m.calls.Keys)-1]
+}
+
+// KeysCallsWhere returns the calls made to Keys that fn returns true for.
+func (m *MockClash) KeysCallsWhere(fn func(MockClashKeysCall) bool) []MockClashKeysCall {
+	var calls []MockClashKeysCall
+	for _, call := range m.KeysCalls() {
+		if fn(call) {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// AssertKeysCalledWith reports through t unless Keys was called with args
+// matching the matchers, and returns whether it was.
+func (m *MockClash) AssertKeysCalledWith(t testing.TB) bool {
+	t.Helper()
+	m.lockKeys.Lock()
+	defer m.lockKeys.Unlock()
+
+	if len(m.calls.Keys) > 0 {
+		return true
+	}
+	t.Errorf("mocker: MockClash.Keys wasn't called")
+	return false
+}
+
+// KeysCall describes the calls made to Keys with args matching args, which
+// may be match.Matchers, for asserting on their order with mocker.InOrder.
+func (m *MockClash) KeysCall() mocker.Call {
+	m.lockKeys.Lock()
+	defer m.lockKeys.Unlock()
+
+	var seqs []uint64
+	seqs = append(seqs, m.seqs.Keys...)
+	return mocker.Call{
+		Desc: mocker.Describe("MockClash.Keys"),
+		Seqs: seqs,
+	}
+}
+
+// WaitForKeys blocks until Keys has been called at least n times, returning
+// nil, or until ctx is done, returning its error.
+func (m *MockClash) WaitForKeys(ctx context.Context, n int) error {
+	m.lockKeys.Lock()
+	defer m.lockKeys.Unlock()
+
+	if m.conds.Keys == nil {
+		m.conds.Keys = sync.NewCond(&m.lockKeys)
+	}
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		select {
+		case <-ctx.Done():
+			m.lockKeys.Lock()
+			m.conds.Keys.Broadcast()
+			m.lockKeys.Unlock()
+		case <-stop:
+		}
+	}()
+	for len(m.calls.Keys) < n {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		m.conds.Keys.Wait()
+	}
+	return nil
+}
+
+// ResetKeys resets the calls made to Keys. Stubs set with OnKeysCall for calls
+// yet to be made keep their calls, now counted from the reset.
+func (m *MockClash) ResetKeys() {
+	m.lockKeys.Lock()
+	defer m.lockKeys.Unlock()
+
+	if n := len(m.calls.Keys); n > 0 && m.onCalls.Keys != nil {
+		onCalls := make(map[int]MockClashKeysFunc, len(m.onCalls.Keys))
+		for i, fn := range m.onCalls.Keys {
+			if i >= n {
+				onCalls[i-n] = fn
+			}
+		}
+		m.onCalls.Keys = onCalls
+	}
+	m.calls.Keys = nil
+	m.seqs.Keys = nil
+	m.unstubbed.Keys = 0
+}
+
+// OnKeysCall makes the n'th call to Keys, counting from 0 like KeysCalls, call fn
+// rather than KeysFunc.
+func (m *MockClash) OnKeysCall(n int, fn MockClashKeysFunc) {
+	m.lockKeys.Lock()
+	defer m.lockKeys.Unlock()
+
+	if m.onCalls.Keys == nil {
+		m.onCalls.Keys = make(map[int]MockClashKeysFunc)
+	}
+	m.onCalls.Keys[n] = fn
+}
+
+// KeysReturnsSequence queues fns for the next calls to Keys to call in turn, one
+// per call, after any queued before, then falling back to KeysFunc. Calls
+// stubbed with OnKeysCall don't use up the queue.
+func (m *MockClash) KeysReturnsSequence(fns ...MockClashKeysFunc) {
+	m.lockKeys.Lock()
+	defer m.lockKeys.Unlock()
+
+	m.queued.Keys = append(m.queued.Keys, fns...)
+}
+
+// WithKeys sets KeysFunc to fn, taking the lock calls to Keys take so it's safe
+// once the mock's shared, and returns the mock.
+func (m *MockClash) WithKeys(fn MockClashKeysFunc) *MockClash {
+	m.lockKeys.Lock()
+	m.KeysFunc = fn
+	m.lockKeys.Unlock()
+	return m
+}
+
+// MockClashDoFunc is the func MockClash.Do calls.
+type MockClashDoFunc func()
+
+// MockClashDoCall is a call made to MockClash.Do.
+type MockClashDoCall struct {
+	// Panic is the value the call panicked with, if it did.
+	Panic interface{}
+}
+
+// Do mocks base method by wrapping the associated func.
+func (m *MockClash) Do() {
+	m.lockDo.Lock()
+	fn := m.DoFunc
+	if onCall, ok := m.onCalls.Do[len(m.calls.Do)]; ok {
+		fn = onCall
+		delete(m.onCalls.Do, len(m.calls.Do))
+	} else if len(m.queued.Do) > 0 {
+		fn = m.queued.Do[0]
+		m.queued.Do = m.queued.Do[1:]
+	}
+	if fn == nil {
+		if m.t == nil {
+			m.lockDo.Unlock()
+			panic("mocker: MockClash.DoFunc is nil but MockClash.Do was called.")
+		}
+		m.unstubbed.Do++
+	}
+	call := &MockClashDoCall{}
+	m.calls.Do = append(m.calls.Do, call)
+	m.seqs.Do = append(m.seqs.Do, mocker.Sequence())
+	if m.conds.Do != nil {
+		m.conds.Do.Broadcast()
+	}
+	m.lockDo.Unlock()
+
+	defer func() {
+		r := recover()
+		m.lockDo.Lock()
+		call.Panic = r
+		m.lockDo.Unlock()
+		if r != nil {
+			panic(r)
+		}
+	}()
+
+	if fn == nil {
+		return
+	}
+
+	fn()
+}
+
+// DoCalled returns true if Do was called at least once.
+func (m *MockClash) DoCalled() bool {
+	m.lockDo.Lock()
+	defer m.lockDo.Unlock()
+
+	return len(m.calls.Do) > 0
+}
+
+// DoCalls returns the calls made to Do.
+func (m *MockClash) DoCalls() []MockClashDoCall {
+	m.lockDo.Lock()
+	defer m.lockDo.Unlock()
+
+	var calls []MockClashDoCall
+	for _, call := range m.calls.Do {
+		calls = append(calls, *call)
+	}
+	return calls
+}
+
+// DoCallCount returns the number of calls made to Do.
+func (m *MockClash) DoCallCount() int {
+	m.lockDo.Lock()
+	defer m.lockDo.Unlock()
+
+	return len(m.calls.Do)
+}
+
+// DoCallAt returns the i'th call made to Do, counting from 0, failing the
+// test through t if there weren't that many calls.
+func (m *MockClash) DoCallAt(t testing.TB, i int) MockClashDoCall {
+	t.Helper()
+	m.lockDo.Lock()
+	defer m.lockDo.Unlock()
+
+	if i < 0 || i >= len(m.calls.Do) {
+		t.Fatalf("mocker: MockClash.Do was called %v times, wanted call %v", len(m.calls.Do), i)
+	}
+	return *m.calls.Do[i]
+}
+
+// DoLastCall returns the last call made to Do, failing the test through t
+// if there weren't any.
+func (m *MockClash) DoLastCall(t testing.TB) MockClashDoCall {
+	t.Helper()
+	m.lockDo.Lock()
+	defer m.lockDo.Unlock()
+
+	if len(m.calls.Do) == 0 {
+		t.Fatalf("mocker: MockClash.Do wasn't called")
+	}
+	return *m.calls.Do[len(m.calls.Do)-1]
+}
+
+// DoCallsWhere returns the calls made to Do that fn returns true for.
+func (m *MockClash) DoCallsWhere(fn func(MockClashDoCall) bool) []MockClashDoCall {
+	var calls []MockClashDoCall
+	for _, call := range m.DoCalls() {
+		if fn(call) {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// AssertDoCalledWith reports through t unless Do was called with args
+// matching the matchers, and returns whether it was.
+func (m *MockClash) AssertDoCalledWith(t testing.TB) bool {
+	t.Helper()
+	m.lockDo.Lock()
+	defer m.lockDo.Unlock()
+
+	if len(m.calls.Do) > 0 {
+		return true
+	}
+	t.Errorf("mocker: MockClash.Do wasn't called")
+	return false
+}
+
+// DoCall_2 describes the calls made to Do with args matching args, which
+// may be match.Matchers, for asserting on their order with mocker.InOrder.
+func (m *MockClash) DoCall_2() mocker.Call {
+	m.lockDo.Lock()
+	defer m.lockDo.Unlock()
+
+	var seqs []uint64
+	seqs = append(seqs, m.seqs.Do...)
+	return mocker.Call{
+		Desc: mocker.Describe("MockClash.Do"),
+		Seqs: seqs,
+	}
+}
+
+// WaitForDo blocks until Do has been called at least n times, returning
+// nil, or until ctx is done, returning its error.
+func (m *MockClash) WaitForDo(ctx context.Context, n int) error {
+	m.lockDo.Lock()
+	defer m.lockDo.Unlock()
+
+	if m.conds.Do == nil {
+		m.conds.Do = sync.NewCond(&m.lockDo)
+	}
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		select {
+		case <-ctx.Done():
+			m.lockDo.Lock()
+			m.conds.Do.Broadcast()
+			m.lockDo.Unlock()
+		case <-stop:
+		}
+	}()
+	for len(m.calls.Do) < n {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		m.conds.Do.Wait()
+	}
+	return nil
+}
+
+// ResetDo resets the calls made to Do. Stubs set with OnDoCall for calls
+// yet to be made keep their calls, now counted from the reset.
+func (m *MockClash) ResetDo() {
+	m.lockDo.Lock()
+	defer m.lockDo.Unlock()
+
+	if n := len(m.calls.Do); n > 0 && m.onCalls.Do != nil {
+		onCalls := make(map[int]MockClashDoFunc, len(m.onCalls.Do))
+		for i, fn := range m.onCalls.Do {
+			if i >= n {
+				onCalls[i-n] = fn
+			}
+		}
+		m.onCalls.Do = onCalls
+	}
+	m.calls.Do = nil
+	m.seqs.Do = nil
+	m.unstubbed.Do = 0
+}
+
+// OnDoCall makes the n'th call to Do, counting from 0 like DoCalls, call fn
+// rather than DoFunc.
+func (m *MockClash) OnDoCall(n int, fn MockClashDoFunc) {
+	m.lockDo.Lock()
+	defer m.lockDo.Unlock()
+
+	if m.onCalls.Do == nil {
+		m.onCalls.Do = make(map[int]MockClashDoFunc)
+	}
+	m.onCalls.Do[n] = fn
+}
+
+// DoReturnsSequence queues fns for the next calls to Do to call in turn, one
+// per call, after any queued before, then falling back to DoFunc. Calls
+// stubbed with OnDoCall don't use up the queue.
+func (m *MockClash) DoReturnsSequence(fns ...MockClashDoFunc) {
+	m.lockDo.Lock()
+	defer m.lockDo.Unlock()
+
+	m.queued.Do = append(m.queued.Do, fns...)
+}
+
+// WithDo sets DoFunc to fn, taking the lock calls to Do take so it's safe
+// once the mock's shared, and returns the mock.
+func (m *MockClash) WithDo(fn MockClashDoFunc) *MockClash {
+	m.lockDo.Lock()
+	m.DoFunc = fn
+	m.lockDo.Unlock()
+	return m
+}
+
+// MockClashDoCallFunc is the func MockClash.DoCall calls.
+type MockClashDoCallFunc func(id string)
+
+// MockClashDoCallCall is a call made to MockClash.DoCall.
+type MockClashDoCallCall struct {
+	Id string
+
+	// Panic is the value the call panicked with, if it did.
+	Panic interface{}
+}
+
+// DoCall mocks base method by wrapping the associated func.
+func (m *MockClash) DoCall(id string) {
+	m.lockDoCall.Lock()
+	fn := m.DoCallFunc
+	if onCall, ok := m.onCalls.DoCall[len(m.calls.DoCall)]; ok {
+		fn = onCall
+		delete(m.onCalls.DoCall, len(m.calls.DoCall))
+	} else if len(m.queued.DoCall) > 0 {
+		fn = m.queued.DoCall[0]
+		m.queued.DoCall = m.queued.DoCall[1:]
+	}
+	if fn == nil {
+		if m.t == nil {
+			m.lockDoCall.Unlock()
+			panic("mocker: MockClash.DoCallFunc is nil but MockClash.DoCall was called.")
+		}
+		m.unstubbed.DoCall++
+	}
+	call := &MockClashDoCallCall{
+		Id: id,
+	}
+	m.calls.DoCall = append(m.calls.DoCall, call)
+	m.seqs.DoCall = append(m.seqs.DoCall, mocker.Sequence())
+	if m.conds.DoCall != nil {
+		m.conds.DoCall.Broadcast()
+	}
+	m.lockDoCall.Unlock()
+
+	defer func() {
+		r := recover()
+		m.lockDoCall.Lock()
+		call.Panic = r
+		m.lockDoCall.Unlock()
+		if r != nil {
+			panic(r)
+		}
+	}()
+
+	if fn == nil {
+		return
+	}
+
+	fn(id)
+}
+
+// DoCallCalled returns true if DoCall was called at least once.
+func (m *MockClash) DoCallCalled() bool {
+	m.lockDoCall.Lock()
+	defer m.lockDoCall.Unlock()
+
+	return len(m.calls.DoCall) > 0
+}
+
+// DoCallCalls returns the calls made to DoCall.
+func (m *MockClash) DoCallCalls() []MockClashDoCallCall {
+	m.lockDoCall.Lock()
+	defer m.lockDoCall.Unlock()
+
+	var calls []MockClashDoCallCall
+	for _, call := range m.calls.DoCall {
+		calls = append(calls, *call)
+	}
+	return calls
+}
+
+// DoCallCallCount returns the number of calls made to DoCall.
+func (m *MockClash) DoCallCallCount() int {
+	m.lockDoCall.Lock()
+	defer m.lockDoCall.Unlock()
+
+	return len(m.calls.DoCall)
+}
+
+// DoCallCallAt returns the i'th call made to DoCall, counting from 0, failing the
+// test through t if there weren't that many calls.
+func (m *MockClash) DoCallCallAt(t testing.TB, i int) MockClashDoCallCall {
+	t.Helper()
+	m.lockDoCall.Lock()
+	defer m.lockDoCall.Unlock()
+
+	if i < 0 || i >= len(m.calls.DoCall) {
+		t.Fatalf("mocker: MockClash.DoCall was called %v times, wanted call %v", len(m.calls.DoCall), i)
+	}
+	return *m.calls.DoCall[i]
+}
+
+// DoCallLastCall returns the last call made to DoCall, failing the test through t
+// if there weren't any.
+func (m *MockClash) DoCallLastCall(t testing.TB) MockClashDoCallCall {
+	t.Helper()
+	m.lockDoCall.Lock()
+	defer m.lockDoCall.Unlock()
+
+	if len(m.calls.DoCall) == 0 {
+		t.Fatalf("mocker: MockClash.DoCall wasn't called")
+	}
+	return *m.calls.DoCall[len(m.calls.DoCall)-1]
+}
+
+// DoCallCallsWhere returns the calls made to DoCall that fn returns true for.
+func (m *MockClash) DoCallCallsWhere(fn func(MockClashDoCallCall) bool) []MockClashDoCallCall {
+	var calls []MockClashDoCallCall
+	for _, call := range m.DoCallCalls() {
+		if fn(call) {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// AssertDoCallCalledWith reports through t unless DoCall was called with args
+// matching the matchers, and returns whether it was.
+func (m *MockClash) AssertDoCallCalledWith(t testing.TB, id match.Matcher) bool {
+	t.Helper()
+	m.lockDoCall.Lock()
+	defer m.lockDoCall.Unlock()
+
+	for _, call := range m.calls.DoCall {
+		if id.Match(call.Id) {
+			return true
+		}
+	}
+	t.Errorf("mocker: MockClash.DoCall wasn't called with (%v)", id)
+	return false
+}
+
+// DoCallCall describes the calls made to DoCall with args matching args, which
+// may be match.Matchers, for asserting on their order with mocker.InOrder.
+func (m *MockClash) DoCallCall(id interface{}) mocker.Call {
+	m.lockDoCall.Lock()
+	defer m.lockDoCall.Unlock()
+
+	var seqs []uint64
+	for i, call := range m.calls.DoCall {
+		if match.Of(id).Match(call.Id) {
+			seqs = append(seqs, m.seqs.DoCall[i])
+		}
+	}
+	return mocker.Call{
+		Desc: mocker.Describe("MockClash.DoCall", id),
+		Seqs: seqs,
+	}
+}
+
+// WaitForDoCall blocks until DoCall has been called at least n times, returning
+// nil, or until ctx is done, returning its error.
+func (m *MockClash) WaitForDoCall(ctx context.Context, n int) error {
+	m.lockDoCall.Lock()
+	defer m.lockDoCall.Unlock()
+
+	if m.conds.DoCall == nil {
+		m.conds.DoCall = sync.NewCond(&m.lockDoCall)
+	}
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		select {
+		case <-ctx.Done():
+			m.lockDoCall.Lock()
+			m.conds.DoCall.Broadcast()
+			m.lockDoCall.Unlock()
+		case <-stop:
+		}
+	}()
+	for len(m.calls.DoCall) < n {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		m.conds.DoCall.Wait()
+	}
+	return nil
+}
+
+// ResetDoCall resets the calls made to DoCall. Stubs set with OnDoCallCall for calls
+// yet to be made keep their calls, now counted from the reset.
+func (m *MockClash) ResetDoCall() {
+	m.lockDoCall.Lock()
+	defer m.lockDoCall.Unlock()
+
+	if n := len(m.calls.DoCall); n > 0 && m.onCalls.DoCall != nil {
+		onCalls := make(map[int]MockClashDoCallFunc, len(m.onCalls.DoCall))
+		for i, fn := range m.onCalls.DoCall {
+			if i >= n {
+				onCalls[i-n] = fn
+			}
+		}
+		m.onCalls.DoCall = onCalls
+	}
+	m.calls.DoCall = nil
+	m.seqs.DoCall = nil
+	m.unstubbed.DoCall = 0
+}
+
+// OnDoCallCall makes the n'th call to DoCall, counting from 0 like DoCallCalls, call fn
+// rather than DoCallFunc.
+func (m *MockClash) OnDoCallCall(n int, fn MockClashDoCallFunc) {
+	m.lockDoCall.Lock()
+	defer m.lockDoCall.Unlock()
+
+	if m.onCalls.DoCall == nil {
+		m.onCalls.DoCall = make(map[int]MockClashDoCallFunc)
+	}
+	m.onCalls.DoCall[n] = fn
+}
+
+// DoCallReturnsSequence queues fns for the next calls to DoCall to call in turn, one
+// per call, after any queued before, then falling back to DoCallFunc. Calls
+// stubbed with OnDoCallCall don't use up the queue.
+func (m *MockClash) DoCallReturnsSequence(fns ...MockClashDoCallFunc) {
+	m.lockDoCall.Lock()
+	defer m.lockDoCall.Unlock()
+
+	m.queued.DoCall = append(m.queued.DoCall, fns...)
+}
+
+// WithDoCall sets DoCallFunc to fn, taking the lock calls to DoCall take so it's safe
+// once the mock's shared, and returns the mock.
+func (m *MockClash) WithDoCall(fn MockClashDoCallFunc) *MockClash {
+	m.lockDoCall.Lock()
+	m.DoCallFunc = fn
+	m.lockDoCall.Unlock()
+	return m
+}
+
+// MockClashAllFunc is the func MockClash.All calls.
+type MockClashAllFunc func() []string
+
+// MockClashAllCall is a call made to MockClash.All.
+type MockClashAllCall struct {
+	Ret0 []string
+
+	// Panic is the value the call panicked with, if it did.
+	Panic interface{}
+}
+
+// All mocks base method by wrapping the associated func.
+func (m *MockClash) All() []string {
+	m.lockAll.Lock()
+	fn := m.AllFunc
+	if onCall, ok := m.onCalls.All[len(m.calls.All)]; ok {
+		fn = onCall
+		delete(m.onCalls.All, len(m.calls.All))
+	} else if len(m.queued.All) > 0 {
+		fn = m.queued.All[0]
+		m.queued.All = m.queued.All[1:]
+	}
+	if fn == nil {
+		if m.t == nil {
+			m.lockAll.Unlock()
+			panic("mocker: MockClash.AllFunc is nil but MockClash.All was called.")
+		}
+		m.unstubbed.All++
+	}
+	call := &MockClashAllCall{}
+	m.calls.All = append(m.calls.All, call)
+	m.seqs.All = append(m.seqs.All, mocker.Sequence())
+	if m.conds.All != nil {
+		m.conds.All.Broadcast()
+	}
+	m.lockAll.Unlock()
+
+	var ret0 []string
+	defer func() {
+		r := recover()
+		m.lockAll.Lock()
+		call.Ret0 = ret0
+		call.Panic = r
+		m.lockAll.Unlock()
+		if r != nil {
+			panic(r)
+		}
+	}()
+
+	if fn == nil {
+		return ret0
+	}
+
+	ret0 = fn()
+	return ret0
+}
+
+// AllCalled returns true if All was called at least once.
+func (m *MockClash) AllCalled() bool {
+	m.lockAll.Lock()
+	defer m.lockAll.Unlock()
+
+	return len(m.calls.All) > 0
+}
+
+// AllCalls returns the calls made to All.
+func (m *MockClash) AllCalls() []MockClashAllCall {
+	m.lockAll.Lock()
+	defer m.lockAll.Unlock()
+
+	var calls []MockClashAllCall
+	for _, call := range m.calls.All {
+		calls = append(calls, *call)
+	}
+	return calls
+}
+
+// AllCallCount returns the number of calls made to All.
+func (m *MockClash) AllCallCount() int {
+	m.lockAll.Lock()
+	defer m.lockAll.Unlock()
+
+	return len(m.calls.All)
+}
+
+// AllCallAt returns the i'th call made to All, counting from 0, failing the
+// test through t if there weren't that many calls.
+func (m *MockClash) AllCallAt(t testing.TB, i int) MockClashAllCall {
+	t.Helper()
+	m.lockAll.Lock()
+	defer m.lockAll.Unlock()
+
+	if i < 0 || i >= len(m.calls.All) {
+		t.Fatalf("mocker: MockClash.All was called %v times, wanted call %v", len(m.calls.All), i)
+	}
+	return *m.calls.All[i]
+}
+
+// AllLastCall returns the last call made to All, failing the test through t
+// if there weren't any.
+func (m *MockClash) AllLastCall(t testing.TB) MockClashAllCall {
+	t.Helper()
+	m.lockAll.Lock()
+	defer m.lockAll.Unlock()
+
+	if len(m.calls.All) == 0 {
+		t.Fatalf("mocker: MockClash.All wasn't called")
+	}
+	return *m.calls.All[len(m.calls.All)-1]
+}
+
+// AllCallsWhere returns the calls made to All that fn returns true for.
+func (m *MockClash) AllCallsWhere(fn func(MockClashAllCall) bool) []MockClashAllCall {
+	var calls []MockClashAllCall
+	for _, call := range m.AllCalls() {
+		if fn(call) {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// AssertAllCalledWith reports through t unless All was called with args
+// matching the matchers, and returns whether it was.
+func (m *MockClash) AssertAllCalledWith(t testing.TB) bool {
+	t.Helper()
+	m.lockAll.Lock()
+	defer m.lockAll.Unlock()
+
+	if len(m.calls.All) > 0 {
+		return true
+	}
+	t.Errorf("mocker: MockClash.All wasn't called")
+	return false
+}
+
+// AllCall describes the calls made to All with args matching args, which
+// may be match.Matchers, for asserting on their order with mocker.InOrder.
+func (m *MockClash) AllCall() mocker.Call {
+	m.lockAll.Lock()
+	defer m.lockAll.Unlock()
+
+	var seqs []uint64
+	seqs = append(seqs, m.seqs.All...)
+	return mocker.Call{
+		Desc: mocker.Describe("MockClash.All"),
+		Seqs: seqs,
+	}
+}
+
+// WaitForAll blocks until All has been called at least n times, returning
+// nil, or until ctx is done, returning its error.
+func (m *MockClash) WaitForAll(ctx context.Context, n int) error {
+	m.lockAll.Lock()
+	defer m.lockAll.Unlock()
+
+	if m.conds.All == nil {
+		m.conds.All = sync.NewCond(&m.lockAll)
+	}
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		select {
+		case <-ctx.Done():
+			m.lockAll.Lock()
+			m.conds.All.Broadcast()
+			m.lockAll.Unlock()
+		case <-stop:
+		}
+	}()
+	for len(m.calls.All) < n {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		m.conds.All.Wait()
+	}
+	return nil
+}
+
+// ResetAll_2 resets the calls made to All. Stubs set with OnAllCall for calls
+// yet to be made keep their calls, now counted from the reset.
+func (m *MockClash) ResetAll_2() {
+	m.lockAll.Lock()
+	defer m.lockAll.Unlock()
+
+	if n := len(m.calls.All); n > 0 && m.onCalls.All != nil {
+		onCalls := make(map[int]MockClashAllFunc, len(m.onCalls.All))
+		for i, fn := range m.onCalls.All {
+			if i >= n {
+				onCalls[i-n] = fn
+			}
+		}
+		m.onCalls.All = onCalls
+	}
+	m.calls.All = nil
+	m.seqs.All = nil
+	m.unstubbed.All = 0
+}
+
+// OnAllCall makes the n'th call to All, counting from 0 like AllCalls, call fn
+// rather than AllFunc.
+func (m *MockClash) OnAllCall(n int, fn MockClashAllFunc) {
+	m.lockAll.Lock()
+	defer m.lockAll.Unlock()
+
+	if m.onCalls.All == nil {
+		m.onCalls.All = make(map[int]MockClashAllFunc)
+	}
+	m.onCalls.All[n] = fn
+}
+
+// AllReturnsSequence queues fns for the next calls to All to call in turn, one
+// per call, after any queued before, then falling back to AllFunc. Calls
+// stubbed with OnAllCall don't use up the queue.
+func (m *MockClash) AllReturnsSequence(fns ...MockClashAllFunc) {
+	m.lockAll.Lock()
+	defer m.lockAll.Unlock()
+
+	m.queued.All = append(m.queued.All, fns...)
+}
+
+// WithAll sets AllFunc to fn, taking the lock calls to All take so it's safe
+// once the mock's shared, and returns the mock.
+func (m *MockClash) WithAll(fn MockClashAllFunc) *MockClash {
+	m.lockAll.Lock()
+	m.AllFunc = fn
+	m.lockAll.Unlock()
+	return m
+}
+
+// MockClashStubsFunc is the func MockClash.Stubs calls.
+type MockClashStubsFunc func()
+
+// MockClashStubsCall is a call made to MockClash.Stubs.
+type MockClashStubsCall struct {
+	// Panic is the value the call panicked with, if it did.
+	Panic interface{}
+}
+
+// Stubs mocks base method by wrapping the associated func.
+func (m *MockClash) Stubs() {
+	m.lockStubs.Lock()
+	fn := m.StubsFunc
+	if onCall, ok := m.onCalls.Stubs[len(m.calls.Stubs)]; ok {
+		fn = onCall
+		delete(m.onCalls.Stubs, len(m.calls.Stubs))
+	} else if len(m.queued.Stubs) > 0 {
+		fn = m.queued.Stubs[0]
+		m.queued.Stubs = m.queued.Stubs[1:]
+	}
+	if fn == nil {
+		if m.t == nil {
+			m.lockStubs.Unlock()
+			panic("mocker: MockClash.StubsFunc is nil but MockClash.Stubs was called.")
+		}
+		m.unstubbed.Stubs++
+	}
+	call := &MockClashStubsCall{}
+	m.calls.Stubs = append(m.calls.Stubs, call)
+	m.seqs.Stubs = append(m.seqs.Stubs, mocker.Sequence())
+	if m.conds.Stubs != nil {
+		m.conds.Stubs.Broadcast()
+	}
+	m.lockStubs.Unlock()
+
+	defer func() {
+		r := recover()
+		m.lockStubs.Lock()
+		call.Panic = r
+		m.lockStubs.Unlock()
+		if r != nil {
+			panic(r)
+		}
+	}()
+
+	if fn == nil {
+		return
+	}
+
+	fn()
+}
+
+// StubsCalled returns true if Stubs was called at least once.
+func (m *MockClash) StubsCalled() bool {
+	m.lockStubs.Lock()
+	defer m.lockStubs.Unlock()
+
+	return len(m.calls.Stubs) > 0
+}
+
+// StubsCalls returns the calls made to Stubs.
+func (m *MockClash) StubsCalls() []MockClashStubsCall {
+	m.lockStubs.Lock()
+	defer m.lockStubs.Unlock()
+
+	var calls []MockClashStubsCall
+	for _, call := range m.calls.Stubs {
+		calls = append(calls, *call)
+	}
+	return calls
+}
+
+// StubsCallCount returns the number of calls made to Stubs.
+func (m *MockClash) StubsCallCount() int {
+	m.lockStubs.Lock()
+	defer m.lockStubs.Unlock()
+
+	return len(m.calls.Stubs)
+}
+
+// StubsCallAt returns the i'th call made to Stubs, counting from 0, failing the
+// test through t if there weren't that many calls.
+func (m *MockClash) StubsCallAt(t testing.TB, i int) MockClashStubsCall {
+	t.Helper()
+	m.lockStubs.Lock()
+	defer m.lockStubs.Unlock()
+
+	if i < 0 || i >= len(m.calls.Stubs) {
+		t.Fatalf("mocker: MockClash.Stubs was called %v times, wanted call %v", len(m.calls.Stubs), i)
+	}
+	return *m.calls.Stubs[i]
+}
+
+// StubsLastCall returns the last call made to Stubs, failing the test through t
+// if there weren't any.
+func (m *MockClash) StubsLastCall(t testing.TB) MockClashStubsCall {
+	t.Helper()
+	m.lockStubs.Lock()
+	defer m.lockStubs.Unlock()
+
+	if len(m.calls.Stubs) == 0 {
+		t.Fatalf("mocker: MockClash.Stubs wasn't called")
+	}
+	return *m.calls.Stubs[len(m.calls.Stubs)-1]
+}
+
+// StubsCallsWhere returns the calls made to Stubs that fn returns true for.
+func (m *MockClash) StubsCallsWhere(fn func(MockClashStubsCall) bool) []MockClashStubsCall {
+	var calls []MockClashStubsCall
+	for _, call := range m.StubsCalls() {
+		if fn(call) {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// AssertStubsCalledWith reports through t unless Stubs was called with args
+// matching the matchers, and returns whether it was.
+func (m *MockClash) AssertStubsCalledWith(t testing.TB) bool {
+	t.Helper()
+	m.lockStubs.Lock()
+	defer m.lockStubs.Unlock()
+
+	if len(m.calls.Stubs) > 0 {
+		return true
+	}
+	t.Errorf("mocker: MockClash.Stubs wasn't called")
+	return false
+}
+
+// StubsCall describes the calls made to Stubs with args matching args, which
+// may be match.Matchers, for asserting on their order with mocker.InOrder.
+func (m *MockClash) StubsCall() mocker.Call {
+	m.lockStubs.Lock()
+	defer m.lockStubs.Unlock()
+
+	var seqs []uint64
+	seqs = append(seqs, m.seqs.Stubs...)
+	return mocker.Call{
+		Desc: mocker.Describe("MockClash.Stubs"),
+		Seqs: seqs,
+	}
+}
+
+// WaitForStubs blocks until Stubs has been called at least n times, returning
+// nil, or until ctx is done, returning its error.
+func (m *MockClash) WaitForStubs(ctx context.Context, n int) error {
+	m.lockStubs.Lock()
+	defer m.lockStubs.Unlock()
+
+	if m.conds.Stubs == nil {
+		m.conds.Stubs = sync.NewCond(&m.lockStubs)
+	}
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		select {
+		case <-ctx.Done():
+			m.lockStubs.Lock()
+			m.conds.Stubs.Broadcast()
+			m.lockStubs.Unlock()
+		case <-stop:
+		}
+	}()
+	for len(m.calls.Stubs) < n {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		m.conds.Stubs.Wait()
+	}
+	return nil
+}
+
+// ResetStubs_2 resets the calls made to Stubs. Stubs set with OnStubsCall for calls
+// yet to be made keep their calls, now counted from the reset.
+func (m *MockClash) ResetStubs_2() {
+	m.lockStubs.Lock()
+	defer m.lockStubs.Unlock()
+
+	if n := len(m.calls.Stubs); n > 0 && m.onCalls.Stubs != nil {
+		onCalls := make(map[int]MockClashStubsFunc, len(m.onCalls.Stubs))
+		for i, fn := range m.onCalls.Stubs {
+			if i >= n {
+				onCalls[i-n] = fn
+			}
+		}
+		m.onCalls.Stubs = onCalls
+	}
+	m.calls.Stubs = nil
+	m.seqs.Stubs = nil
+	m.unstubbed.Stubs = 0
+}
+
+// OnStubsCall makes the n'th call to Stubs, counting from 0 like StubsCalls, call fn
+// rather than StubsFunc.
+func (m *MockClash) OnStubsCall(n int, fn MockClashStubsFunc) {
+	m.lockStubs.Lock()
+	defer m.lockStubs.Unlock()
+
+	if m.onCalls.Stubs == nil {
+		m.onCalls.Stubs = make(map[int]MockClashStubsFunc)
+	}
+	m.onCalls.Stubs[n] = fn
+}
+
+// StubsReturnsSequence queues fns for the next calls to Stubs to call in turn, one
+// per call, after any queued before, then falling back to StubsFunc. Calls
+// stubbed with OnStubsCall don't use up the queue.
+func (m *MockClash) StubsReturnsSequence(fns ...MockClashStubsFunc) {
+	m.lockStubs.Lock()
+	defer m.lockStubs.Unlock()
+
+	m.queued.Stubs = append(m.queued.Stubs, fns...)
+}
+
+// WithStubs sets StubsFunc to fn, taking the lock calls to Stubs take so it's safe
+// once the mock's shared, and returns the mock.
+func (m *MockClash) WithStubs(fn MockClashStubsFunc) *MockClash {
+	m.lockStubs.Lock()
+	m.StubsFunc = fn
+	m.lockStubs.Unlock()
+	return m
+}
+
+// MockClashFooFunc is the func MockClash.Foo calls.
+type MockClashFooFunc func(n int)
+
+// MockClashFooCall is a call made to MockClash.Foo.
+type MockClashFooCall struct {
+	N int
+
+	// Panic is the value the call panicked with, if it did.
+	Panic interface{}
+}
+
+// Foo mocks base method by wrapping the associated func.
+func (m *MockClash) Foo(n int) {
+	m.lockFoo.Lock()
+	fn := m.FooFunc
+	if onCall, ok := m.onCalls.Foo[len(m.calls.Foo)]; ok {
+		fn = onCall
+		delete(m.onCalls.Foo, len(m.calls.Foo))
+	} else if len(m.queued.Foo) > 0 {
+		fn = m.queued.Foo[0]
+		m.queued.Foo = m.queued.Foo[1:]
+	}
+	if fn == nil {
+		if m.t == nil {
+			m.lockFoo.Unlock()
+			panic("mocker: MockClash.FooFunc is nil but MockClash.Foo was called.")
+		}
+		m.unstubbed.Foo++
+	}
+	call := &MockClashFooCall{
+		N: n,
+	}
+	m.calls.Foo = append(m.calls.Foo, call)
+	m.seqs.Foo = append(m.seqs.Foo, mocker.Sequence())
+	if m.conds.Foo != nil {
+		m.conds.Foo.Broadcast()
+	}
+	m.lockFoo.Unlock()
+
+	defer func() {
+		r := recover()
+		m.lockFoo.Lock()
+		call.Panic = r
+		m.lockFoo.Unlock()
+		if r != nil {
+			panic(r)
+		}
+	}()
+
+	if fn == nil {
+		return
+	}
+
+	fn(n)
+}
+
+// FooCalled returns true if Foo was called at least once.
+func (m *MockClash) FooCalled() bool {
+	m.lockFoo.Lock()
+	defer m.lockFoo.Unlock()
+
+	return len(m.calls.Foo) > 0
+}
+
+// FooCalls returns the calls made to Foo.
+func (m *MockClash) FooCalls() []MockClashFooCall {
+	m.lockFoo.Lock()
+	defer m.lockFoo.Unlock()
+
+	var calls []MockClashFooCall
+	for _, call := range m.calls.Foo {
+		calls = append(calls, *call)
+	}
+	return calls
+}
+
+// FooCallCount returns the number of calls made to Foo.
+func (m *MockClash) FooCallCount() int {
+	m.lockFoo.Lock()
+	defer m.lockFoo.Unlock()
+
+	return len(m.calls.Foo)
+}
+
+// FooCallAt returns the i'th call made to Foo, counting from 0, failing the
+// test through t if there weren't that many calls.
+func (m *MockClash) FooCallAt(t testing.TB, i int) MockClashFooCall {
+	t.Helper()
+	m.lockFoo.Lock()
+	defer m.lockFoo.Unlock()
+
+	if i < 0 || i >= len(m.calls.Foo) {
+		t.Fatalf("mocker: MockClash.Foo was called %v times, wanted call %v", len(m.calls.Foo), i)
+	}
+	return *m.calls.Foo[i]
+}
+
+// FooLastCall returns the last call made to Foo, failing the test through t
+// if there weren't any.
+func (m *MockClash) FooLastCall(t testing.TB) MockClashFooCall {
+	t.Helper()
+	m.lockFoo.Lock()
+	defer m.lockFoo.Unlock()
+
+	if len(m.calls.Foo) == 0 {
+		t.Fatalf("mocker: MockClash.Foo wasn't called")
+	}
+	return *m.calls.Foo[len(m.calls.Foo)-1]
+}
+
+// FooCallsWhere returns the calls made to Foo that fn returns true for.
+func (m *MockClash) FooCallsWhere(fn func(MockClashFooCall) bool) []MockClashFooCall {
+	var calls []MockClashFooCall
+	for _, call := range m.FooCalls() {
+		if fn(call) {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// AssertFooCalledWith reports through t unless Foo was called with args
+// matching the matchers, and returns whether it was.
+func (m *MockClash) AssertFooCalledWith(t testing.TB, n match.Matcher) bool {
+	t.Helper()
+	m.lockFoo.Lock()
+	defer m.lockFoo.Unlock()
+
+	for _, call := range m.calls.Foo {
+		if n.Match(call.N) {
+			return true
+		}
+	}
+	t.Errorf("mocker: MockClash.Foo wasn't called with (%v)", n)
+	return false
+}
+
+// FooCall describes the calls made to Foo with args matching args, which
+// may be match.Matchers, for asserting on their order with mocker.InOrder.
+func (m *MockClash) FooCall(n interface{}) mocker.Call {
+	m.lockFoo.Lock()
+	defer m.lockFoo.Unlock()
+
+	var seqs []uint64
+	for i, call := range m.calls.Foo {
+		if match.Of(n).Match(call.N) {
+			seqs = append(seqs, m.seqs.Foo[i])
+		}
+	}
+	return mocker.Call{
+		Desc: mocker.Describe("MockClash.Foo", n),
+		Seqs: seqs,
+	}
+}
+
+// WaitForFoo blocks until Foo has been called at least n_2 times, returning
+// nil, or until ctx is done, returning its error.
+func (m *MockClash) WaitForFoo(ctx context.Context, n_2 int) error {
+	m.lockFoo.Lock()
+	defer m.lockFoo.Unlock()
+
+	if m.conds.Foo == nil {
+		m.conds.Foo = sync.NewCond(&m.lockFoo)
+	}
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		select {
+		case <-ctx.Done():
+			m.lockFoo.Lock()
+			m.conds.Foo.Broadcast()
+			m.lockFoo.Unlock()
+		case <-stop:
+		}
+	}()
+	for len(m.calls.Foo) < n_2 {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		m.conds.Foo.Wait()
+	}
+	return nil
+}
+
+// ResetFoo_2 resets the calls made to Foo. Stubs set with OnFooCall for calls
+// yet to be made keep their calls, now counted from the reset.
+func (m *MockClash) ResetFoo_2() {
+	m.lockFoo.Lock()
+	defer m.lockFoo.Unlock()
+
+	if n_2 := len(m.calls.Foo); n_2 > 0 && m.onCalls.Foo != nil {
+		onCalls := make(map[int]MockClashFooFunc, len(m.onCalls.Foo))
+		for i, fn := range m.onCalls.Foo {
+			if i >= n_2 {
+				onCalls[i-n_2] = fn
+			}
+		}
+		m.onCalls.Foo = onCalls
+	}
+	m.calls.Foo = nil
+	m.seqs.Foo = nil
+	m.unstubbed.Foo = 0
+}
+
+// OnFooCall makes the n'th call to Foo, counting from 0 like FooCalls, call fn
+// rather than FooFunc.
+func (m *MockClash) OnFooCall(n int, fn MockClashFooFunc) {
+	m.lockFoo.Lock()
+	defer m.lockFoo.Unlock()
+
+	if m.onCalls.Foo == nil {
+		m.onCalls.Foo = make(map[int]MockClashFooFunc)
+	}
+	m.onCalls.Foo[n] = fn
+}
+
+// FooReturnsSequence queues fns for the next calls to Foo to call in turn, one
+// per call, after any queued before, then falling back to FooFunc. Calls
+// stubbed with OnFooCall don't use up the queue.
+func (m *MockClash) FooReturnsSequence(fns ...MockClashFooFunc) {
+	m.lockFoo.Lock()
+	defer m.lockFoo.Unlock()
+
+	m.queued.Foo = append(m.queued.Foo, fns...)
+}
+
+// WithFoo sets FooFunc to fn, taking the lock calls to Foo take so it's safe
+// once the mock's shared, and returns the mock.
+func (m *MockClash) WithFoo(fn MockClashFooFunc) *MockClash {
+	m.lockFoo.Lock()
+	m.FooFunc = fn
+	m.lockFoo.Unlock()
+	return m
+}
+
+// MockClashResetFooFunc is the func MockClash.ResetFoo calls.
+type MockClashResetFooFunc func()
+
+// MockClashResetFooCall is a call made to MockClash.ResetFoo.
+type MockClashResetFooCall struct {
+	// Panic is the value the call panicked with, if it did.
+	Panic interface{}
+}
+
+// ResetFoo mocks base method by wrapping the associated func.
+func (m *MockClash) ResetFoo() {
+	m.lockResetFoo.Lock()
+	fn := m.ResetFooFunc
+	if onCall, ok := m.onCalls.ResetFoo[len(m.calls.ResetFoo)]; ok {
+		fn = onCall
+		delete(m.onCalls.ResetFoo, len(m.calls.ResetFoo))
+	} else if len(m.queued.ResetFoo) > 0 {
+		fn = m.queued.ResetFoo[0]
+		m.queued.ResetFoo = m.queued.ResetFoo[1:]
+	}
+	if fn == nil {
+		if m.t == nil {
+			m.lockResetFoo.Unlock()
+			panic("mocker: MockClash.ResetFooFunc is nil but MockClash.ResetFoo was called.")
+		}
+		m.unstubbed.ResetFoo++
+	}
+	call := &MockClashResetFooCall{}
+	m.calls.ResetFoo = append(m.calls.ResetFoo, call)
+	m.seqs.ResetFoo = append(m.seqs.ResetFoo, mocker.Sequence())
+	if m.conds.ResetFoo != nil {
+		m.conds.ResetFoo.Broadcast()
+	}
+	m.lockResetFoo.Unlock()
+
+	defer func() {
+		r := recover()
+		m.lockResetFoo.Lock()
+		call.Panic = r
+		m.lockResetFoo.Unlock()
+		if r != nil {
+			panic(r)
+		}
+	}()
+
+	if fn == nil {
+		return
+	}
+
+	fn()
+}
+
+// ResetFooCalled returns true if ResetFoo was called at least once.
+func (m *MockClash) ResetFooCalled() bool {
+	m.lockResetFoo.Lock()
+	defer m.lockResetFoo.Unlock()
+
+	return len(m.calls.ResetFoo) > 0
+}
+
+// ResetFooCalls returns the calls made to ResetFoo.
+func (m *MockClash) ResetFooCalls() []MockClashResetFooCall {
+	m.lockResetFoo.Lock()
+	defer m.lockResetFoo.Unlock()
+
+	var calls []MockClashResetFooCall
+	for _, call := range m.calls.ResetFoo {
+		calls = append(calls, *call)
+	}
+	return calls
+}
+
+// ResetFooCallCount returns the number of calls made to ResetFoo.
+func (m *MockClash) ResetFooCallCount() int {
+	m.lockResetFoo.Lock()
+	defer m.lockResetFoo.Unlock()
+
+	return len(m.calls.ResetFoo)
+}
+
+// ResetFooCallAt returns the i'th call made to ResetFoo, counting from 0, failing the
+// test through t if there weren't that many calls.
+func (m *MockClash) ResetFooCallAt(t testing.TB, i int) MockClashResetFooCall {
+	t.Helper()
+	m.lockResetFoo.Lock()
+	defer m.lockResetFoo.Unlock()
+
+	if i < 0 || i >= len(m.calls.ResetFoo) {
+		t.Fatalf("mocker: MockClash.ResetFoo was called %v times, wanted call %v", len(m.calls.ResetFoo), i)
+	}
+	return *m.calls.ResetFoo[i]
+}
+
+// ResetFooLastCall returns the last call made to ResetFoo, failing the test through t
+// if there weren't any.
+func (m *MockClash) ResetFooLastCall(t testing.TB) MockClashResetFooCall {
+	t.Helper()
+	m.lockResetFoo.Lock()
+	defer m.lockResetFoo.Unlock()
+
+	if len(m.calls.ResetFoo) == 0 {
+		t.Fatalf("mocker: MockClash.ResetFoo wasn't called")
+	}
+	return *m.calls.ResetFoo[len(m.calls.ResetFoo)-1]
+}
+
+// ResetFooCallsWhere returns the calls made to ResetFoo that fn returns true for.
+func (m *MockClash) ResetFooCallsWhere(fn func(MockClashResetFooCall) bool) []MockClashResetFooCall {
+	var calls []MockClashResetFooCall
+	for _, call := range m.ResetFooCalls() {
+		if fn(call) {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// AssertResetFooCalledWith reports through t unless ResetFoo was called with args
+// matching the matchers, and returns whether it was.
+func (m *MockClash) AssertResetFooCalledWith(t testing.TB) bool {
+	t.Helper()
+	m.lockResetFoo.Lock()
+	defer m.lockResetFoo.Unlock()
+
+	if len(m.calls.ResetFoo) > 0 {
+		return true
+	}
+	t.Errorf("mocker: MockClash.ResetFoo wasn't called")
+	return false
+}
+
+// ResetFooCall describes the calls made to ResetFoo with args matching args, which
+// may be match.Matchers, for asserting on their order with mocker.InOrder.
+func (m *MockClash) ResetFooCall() mocker.Call {
+	m.lockResetFoo.Lock()
+	defer m.lockResetFoo.Unlock()
+
+	var seqs []uint64
+	seqs = append(seqs, m.seqs.ResetFoo...)
+	return mocker.Call{
+		Desc: mocker.Describe("MockClash.ResetFoo"),
+		Seqs: seqs,
+	}
+}
+
+// WaitForResetFoo blocks until ResetFoo has been called at least n times, returning
+// nil, or until ctx is done, returning its error.
+func (m *MockClash) WaitForResetFoo(ctx context.Context, n int) error {
+	m.lockResetFoo.Lock()
+	defer m.lockResetFoo.Unlock()
+
+	if m.conds.ResetFoo == nil {
+		m.conds.ResetFoo = sync.NewCond(&m.lockResetFoo)
+	}
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		select {
+		case <-ctx.Done():
+			m.lockResetFoo.Lock()
+			m.conds.ResetFoo.Broadcast()
+			m.lockResetFoo.Unlock()
+		case <-stop:
+		}
+	}()
+	for len(m.calls.ResetFoo) < n {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		m.conds.ResetFoo.Wait()
+	}
+	return nil
+}
+
+// ResetResetFoo resets the calls made to ResetFoo. Stubs set with OnResetFooCall for calls
+// yet to be made keep their calls, now counted from the reset.
+func (m *MockClash) ResetResetFoo() {
+	m.lockResetFoo.Lock()
+	defer m.lockResetFoo.Unlock()
+
+	if n := len(m.calls.ResetFoo); n > 0 && m.onCalls.ResetFoo != nil {
+		onCalls := make(map[int]MockClashResetFooFunc, len(m.onCalls.ResetFoo))
+		for i, fn := range m.onCalls.ResetFoo {
+			if i >= n {
+				onCalls[i-n] = fn
+			}
+		}
+		m.onCalls.ResetFoo = onCalls
+	}
+	m.calls.ResetFoo = nil
+	m.seqs.ResetFoo = nil
+	m.unstubbed.ResetFoo = 0
+}
+
+// OnResetFooCall makes the n'th call to ResetFoo, counting from 0 like ResetFooCalls, call fn
+// rather than ResetFooFunc.
+func (m *MockClash) OnResetFooCall(n int, fn MockClashResetFooFunc) {
+	m.lockResetFoo.Lock()
+	defer m.lockResetFoo.Unlock()
+
+	if m.onCalls.ResetFoo == nil {
+		m.onCalls.ResetFoo = make(map[int]MockClashResetFooFunc)
+	}
+	m.onCalls.ResetFoo[n] = fn
+}
+
+// ResetFooReturnsSequence queues fns for the next calls to ResetFoo to call in turn, one
+// per call, after any queued before, then falling back to ResetFooFunc. Calls
+// stubbed with OnResetFooCall don't use up the queue.
+func (m *MockClash) ResetFooReturnsSequence(fns ...MockClashResetFooFunc) {
+	m.lockResetFoo.Lock()
+	defer m.lockResetFoo.Unlock()
+
+	m.queued.ResetFoo = append(m.queued.ResetFoo, fns...)
+}
+
+// WithResetFoo sets ResetFooFunc to fn, taking the lock calls to ResetFoo take so it's safe
+// once the mock's shared, and returns the mock.
+func (m *MockClash) WithResetFoo(fn MockClashResetFooFunc) *MockClash {
+	m.lockResetFoo.Lock()
+	m.ResetFooFunc = fn
+	m.lockResetFoo.Unlock()
+	return m
+}
+
+// MockClashWaitFunc is the func MockClash.Wait calls.
+type MockClashWaitFunc func(ctx context.Context, n int)
+
+// MockClashWaitCall is a call made to MockClash.Wait.
+type MockClashWaitCall struct {
+	Ctx context.Context
+	N   int
+
+	// Panic is the value the call panicked with, if it did.
+	Panic interface{}
+}
+
+// Wait mocks base method by wrapping the associated func.
+func (m *MockClash) Wait(ctx context.Context, n int) {
+	m.lockWait.Lock()
+	fn := m.WaitFunc
+	if onCall, ok := m.onCalls.Wait[len(m.calls.Wait)]; ok {
+		fn = onCall
+		delete(m.onCalls.Wait, len(m.calls.Wait))
+	} else if len(m.queued.Wait) > 0 {
+		fn = m.queued.Wait[0]
+		m.queued.Wait = m.queued.Wait[1:]
+	}
+	if fn == nil {
+		if m.t == nil {
+			m.lockWait.Unlock()
+			panic("mocker: MockClash.WaitFunc is nil but MockClash.Wait was called.")
+		}
+		m.unstubbed.Wait++
+	}
+	call := &MockClashWaitCall{
+		Ctx: ctx,
+		N:   n,
+	}
+	m.calls.Wait = append(m.calls.Wait, call)
+	m.seqs.Wait = append(m.seqs.Wait, mocker.Sequence())
+	if m.conds.Wait != nil {
+		m.conds.Wait.Broadcast()
+	}
+	m.lockWait.Unlock()
+
+	defer func() {
+		r := recover()
+		m.lockWait.Lock()
+		call.Panic = r
+		m.lockWait.Unlock()
+		if r != nil {
+			panic(r)
+		}
+	}()
+
+	if fn == nil {
+		return
+	}
+
+	fn(ctx, n)
+}
+
+// WaitCalled returns true if Wait was called at least once.
+func (m *MockClash) WaitCalled() bool {
+	m.lockWait.Lock()
+	defer m.lockWait.Unlock()
+
+	return len(m.calls.Wait) > 0
+}
+
+// WaitCalls returns the calls made to Wait.
+func (m *MockClash) WaitCalls() []MockClashWaitCall {
+	m.lockWait.Lock()
+	defer m.lockWait.Unlock()
+
+	var calls []MockClashWaitCall
+	for _, call := range m.calls.Wait {
+		calls = append(calls, *call)
+	}
+	return calls
+}
+
+// WaitCallCount returns the number of calls made to Wait.
+func (m *MockClash) WaitCallCount() int {
+	m.lockWait.Lock()
+	defer m.lockWait.Unlock()
+
+	return len(m.calls.Wait)
+}
+
+// WaitCallAt returns the i'th call made to Wait, counting from 0, failing the
+// test through t if there weren't that many calls.
+func (m *MockClash) WaitCallAt(t testing.TB, i int) MockClashWaitCall {
+	t.Helper()
+	m.lockWait.Lock()
+	defer m.lockWait.Unlock()
+
+	if i < 0 || i >= len(m.calls.Wait) {
+		t.Fatalf("mocker: MockClash.Wait was called %v times, wanted call %v", len(m.calls.Wait), i)
+	}
+	return *m.calls.Wait[i]
+}
+
+// WaitLastCall returns the last call made to Wait, failing the test through t
+// if there weren't any.
+func (m *MockClash) WaitLastCall(t testing.TB) MockClashWaitCall {
+	t.Helper()
+	m.lockWait.Lock()
+	defer m.lockWait.Unlock()
+
+	if len(m.calls.Wait) == 0 {
+		t.Fatalf("mocker: MockClash.Wait wasn't called")
+	}
+	return *m.calls.Wait[len(m.calls.Wait)-1]
+}
+
+// WaitCallsWhere returns the calls made to Wait that fn returns true for.
+func (m *MockClash) WaitCallsWhere(fn func(MockClashWaitCall) bool) []MockClashWaitCall {
+	var calls []MockClashWaitCall
+	for _, call := range m.WaitCalls() {
+		if fn(call) {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// AssertWaitCalledWith reports through t unless Wait was called with args
+// matching the matchers, and returns whether it was.
+func (m *MockClash) AssertWaitCalledWith(t testing.TB, ctx, n match.Matcher) bool {
+	t.Helper()
+	m.lockWait.Lock()
+	defer m.lockWait.Unlock()
+
+	for _, call := range m.calls.Wait {
+		if ctx.Match(call.Ctx) && n.Match(call.N) {
+			return true
+		}
+	}
+	t.Errorf("mocker: MockClash.Wait wasn't called with (%v, %v)", ctx, n)
+	return false
+}
+
+// WaitCall describes the calls made to Wait with args matching args, which
+// may be match.Matchers, for asserting on their order with mocker.InOrder.
+func (m *MockClash) WaitCall(ctx, n interface{}) mocker.Call {
+	m.lockWait.Lock()
+	defer m.lockWait.Unlock()
+
+	var seqs []uint64
+	for i, call := range m.calls.Wait {
+		if match.Of(ctx).Match(call.Ctx) && match.Of(n).Match(call.N) {
+			seqs = append(seqs, m.seqs.Wait[i])
+		}
+	}
+	return mocker.Call{
+		Desc: mocker.Describe("MockClash.Wait", ctx, n),
+		Seqs: seqs,
+	}
+}
+
+// WaitForWait_2 blocks until Wait has been called at least n_2 times, returning
+// nil, or until ctx_2 is done, returning its error.
+func (m *MockClash) WaitForWait_2(ctx_2 context.Context, n_2 int) error {
+	m.lockWait.Lock()
+	defer m.lockWait.Unlock()
+
+	if m.conds.Wait == nil {
+		m.conds.Wait = sync.NewCond(&m.lockWait)
+	}
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		select {
+		case <-ctx_2.Done():
+			m.lockWait.Lock()
+			m.conds.Wait.Broadcast()
+			m.lockWait.Unlock()
+		case <-stop:
+		}
+	}()
+	for len(m.calls.Wait) < n_2 {
+		if err := ctx_2.Err(); err != nil {
+			return err
+		}
+		m.conds.Wait.Wait()
+	}
+	return nil
+}
+
+// ResetWait resets the calls made to Wait. Stubs set with OnWaitCall for calls
+// yet to be made keep their calls, now counted from the reset.
+func (m *MockClash) ResetWait() {
+	m.lockWait.Lock()
+	defer m.lockWait.Unlock()
+
+	if n_2 := len(m.calls.Wait); n_2 > 0 && m.onCalls.Wait != nil {
+		onCalls := make(map[int]MockClashWaitFunc, len(m.onCalls.Wait))
+		for i, fn := range m.onCalls.Wait {
+			if i >= n_2 {
+				onCalls[i-n_2] = fn
+			}
+		}
+		m.onCalls.Wait = onCalls
+	}
+	m.calls.Wait = nil
+	m.seqs.Wait = nil
+	m.unstubbed.Wait = 0
+}
+
+// OnWaitCall makes the n'th call to Wait, counting from 0 like WaitCalls, call fn
+// rather than WaitFunc.
+func (m *MockClash) OnWaitCall(n int, fn MockClashWaitFunc) {
+	m.lockWait.Lock()
+	defer m.lockWait.Unlock()
+
+	if m.onCalls.Wait == nil {
+		m.onCalls.Wait = make(map[int]MockClashWaitFunc)
+	}
+	m.onCalls.Wait[n] = fn
+}
+
+// WaitReturnsSequence queues fns for the next calls to Wait to call in turn, one
+// per call, after any queued before, then falling back to WaitFunc. Calls
+// stubbed with OnWaitCall don't use up the queue.
+func (m *MockClash) WaitReturnsSequence(fns ...MockClashWaitFunc) {
+	m.lockWait.Lock()
+	defer m.lockWait.Unlock()
+
+	m.queued.Wait = append(m.queued.Wait, fns...)
+}
+
+// WithWait sets WaitFunc to fn, taking the lock calls to Wait take so it's safe
+// once the mock's shared, and returns the mock.
+func (m *MockClash) WithWait(fn MockClashWaitFunc) *MockClash {
+	m.lockWait.Lock()
+	m.WaitFunc = fn
+	m.lockWait.Unlock()
+	return m
+}
+
+// MockClashWaitForWaitFunc is the func MockClash.WaitForWait calls.
+type MockClashWaitForWaitFunc func()
+
+// MockClashWaitForWaitCall is a call made to MockClash.WaitForWait.
+type MockClashWaitForWaitCall struct {
+	// Panic is the value the call panicked with, if it did.
+	Panic interface{}
+}
+
+// WaitForWait mocks base method by wrapping the associated func.
+func (m *MockClash) WaitForWait() {
+	m.lockWaitForWait.Lock()
+	fn := m.WaitForWaitFunc
+	if onCall, ok := m.onCalls.WaitForWait[len(m.calls.WaitForWait)]; ok {
+		fn = onCall
+		delete(m.onCalls.WaitForWait, len(m.calls.WaitForWait))
+	} else if len(m.queued.WaitForWait) > 0 {
+		fn = m.queued.WaitForWait[0]
+		m.queued.WaitForWait = m.queued.WaitForWait[1:]
+	}
+	if fn == nil {
+		if m.t == nil {
+			m.lockWaitForWait.Unlock()
+			panic("mocker: MockClash.WaitForWaitFunc is nil but MockClash.WaitForWait was called.")
+		}
+		m.unstubbed.WaitForWait++
+	}
+	call := &MockClashWaitForWaitCall{}
+	m.calls.WaitForWait = append(m.calls.WaitForWait, call)
+	m.seqs.WaitForWait = append(m.seqs.WaitForWait, mocker.Sequence())
+	if m.conds.WaitForWait != nil {
+		m.conds.WaitForWait.Broadcast()
+	}
+	m.lockWaitForWait.Unlock()
+
+	defer func() {
+		r := recover()
+		m.lockWaitForWait.Lock()
+		call.Panic = r
+		m.lockWaitForWait.Unlock()
+		if r != nil {
+			panic(r)
+		}
+	}()
+
+	if fn == nil {
+		return
+	}
+
+	fn()
+}
+
+// WaitForWaitCalled returns true if WaitForWait was called at least once.
+func (m *MockClash) WaitForWaitCalled() bool {
+	m.lockWaitForWait.Lock()
+	defer m.lockWaitForWait.Unlock()
+
+	return len(m.calls.WaitForWait) > 0
+}
+
+// WaitForWaitCalls returns the calls made to WaitForWait.
+func (m *MockClash) WaitForWaitCalls() []MockClashWaitForWaitCall {
+	m.lockWaitForWait.Lock()
+	defer m.lockWaitForWait.Unlock()
+
+	var calls []MockClashWaitForWaitCall
+	for _, call := range m.calls.WaitForWait {
+		calls = append(calls, *call)
+	}
+	return calls
+}
+
+// WaitForWaitCallCount returns the number of calls made to WaitForWait.
+func (m *MockClash) WaitForWaitCallCount() int {
+	m.lockWaitForWait.Lock()
+	defer m.lockWaitForWait.Unlock()
+
+	return len(m.calls.WaitForWait)
+}
+
+// WaitForWaitCallAt returns the i'th call made to WaitForWait, counting from 0, failing the
+// test through t if there weren't that many calls.
+func (m *MockClash) WaitForWaitCallAt(t testing.TB, i int) MockClashWaitForWaitCall {
+	t.Helper()
+	m.lockWaitForWait.Lock()
+	defer m.lockWaitForWait.Unlock()
+
+	if i < 0 || i >= len(m.calls.WaitForWait) {
+		t.Fatalf("mocker: MockClash.WaitForWait was called %v times, wanted call %v", len(m.calls.WaitForWait), i)
+	}
+	return *m.calls.WaitForWait[i]
+}
+
+// WaitForWaitLastCall returns the last call made to WaitForWait, failing the test through t
+// if there weren't any.
+func (m *MockClash) WaitForWaitLastCall(t testing.TB) MockClashWaitForWaitCall {
+	t.Helper()
+	m.lockWaitForWait.Lock()
+	defer m.lockWaitForWait.Unlock()
+
+	if len(m.calls.WaitForWait) == 0 {
+		t.Fatalf("mocker: MockClash.WaitForWait wasn't called")
+	}
+	return *m.calls.WaitForWait[len(m.calls.WaitForWait)-1]
+}
+
+// WaitForWaitCallsWhere returns the calls made to WaitForWait that fn returns true for.
+func (m *MockClash) WaitForWaitCallsWhere(fn func(MockClashWaitForWaitCall) bool) []MockClashWaitForWaitCall {
+	var calls []MockClashWaitForWaitCall
+	for _, call := range m.WaitForWaitCalls() {
+		if fn(call) {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// AssertWaitForWaitCalledWith reports through t unless WaitForWait was called with args
+// matching the matchers, and returns whether it was.
+func (m *MockClash) AssertWaitForWaitCalledWith(t testing.TB) bool {
+	t.Helper()
+	m.lockWaitForWait.Lock()
+	defer m.lockWaitForWait.Unlock()
+
+	if len(m.calls.WaitForWait) > 0 {
+		return true
+	}
+	t.Errorf("mocker: MockClash.WaitForWait wasn't called")
+	return false
+}
+
+// WaitForWaitCall describes the calls made to WaitForWait with args matching args, which
+// may be match.Matchers, for asserting on their order with mocker.InOrder.
+func (m *MockClash) WaitForWaitCall() mocker.Call {
+	m.lockWaitForWait.Lock()
+	defer m.lockWaitForWait.Unlock()
+
+	var seqs []uint64
+	seqs = append(seqs, m.seqs.WaitForWait...)
+	return mocker.Call{
+		Desc: mocker.Describe("MockClash.WaitForWait"),
+		Seqs: seqs,
+	}
+}
+
+// WaitForWaitForWait blocks until WaitForWait has been called at least n times, returning
+// nil, or until ctx is done, returning its error.
+func (m *MockClash) WaitForWaitForWait(ctx context.Context, n int) error {
+	m.lockWaitForWait.Lock()
+	defer m.lockWaitForWait.Unlock()
+
+	if m.conds.WaitForWait == nil {
+		m.conds.WaitForWait = sync.NewCond(&m.lockWaitForWait)
+	}
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		select {
+		case <-ctx.Done():
+			m.lockWaitForWait.Lock()
+			m.conds.WaitForWait.Broadcast()
+			m.lockWaitForWait.Unlock()
+		case <-stop:
+		}
+	}()
+	for len(m.calls.WaitForWait) < n {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		m.conds.WaitForWait.Wait()
+	}
+	return nil
+}
+
+// ResetWaitForWait resets the calls made to WaitForWait. Stubs set with OnWaitForWaitCall for calls
+// yet to be made keep their calls, now counted from the reset.
+func (m *MockClash) ResetWaitForWait() {
+	m.lockWaitForWait.Lock()
+	defer m.lockWaitForWait.Unlock()
+
+	if n := len(m.calls.WaitForWait); n > 0 && m.onCalls.WaitForWait != nil {
+		onCalls := make(map[int]MockClashWaitForWaitFunc, len(m.onCalls.WaitForWait))
+		for i, fn := range m.onCalls.WaitForWait {
+			if i >= n {
+				onCalls[i-n] = fn
+			}
+		}
+		m.onCalls.WaitForWait = onCalls
+	}
+	m.calls.WaitForWait = nil
+	m.seqs.WaitForWait = nil
+	m.unstubbed.WaitForWait = 0
+}
+
+// OnWaitForWaitCall makes the n'th call to WaitForWait, counting from 0 like WaitForWaitCalls, call fn
+// rather than WaitForWaitFunc.
+func (m *MockClash) OnWaitForWaitCall(n int, fn MockClashWaitForWaitFunc) {
+	m.lockWaitForWait.Lock()
+	defer m.lockWaitForWait.Unlock()
+
+	if m.onCalls.WaitForWait == nil {
+		m.onCalls.WaitForWait = make(map[int]MockClashWaitForWaitFunc)
+	}
+	m.onCalls.WaitForWait[n] = fn
+}
+
+// WaitForWaitReturnsSequence queues fns for the next calls to WaitForWait to call in turn, one
+// per call, after any queued before, then falling back to WaitForWaitFunc. Calls
+// stubbed with OnWaitForWaitCall don't use up the queue.
+func (m *MockClash) WaitForWaitReturnsSequence(fns ...MockClashWaitForWaitFunc) {
+	m.lockWaitForWait.Lock()
+	defer m.lockWaitForWait.Unlock()
+
+	m.queued.WaitForWait = append(m.queued.WaitForWait, fns...)
+}
+
+// WithWaitForWait sets WaitForWaitFunc to fn, taking the lock calls to WaitForWait take so it's safe
+// once the mock's shared, and returns the mock.
+func (m *MockClash) WithWaitForWait(fn MockClashWaitForWaitFunc) *MockClash {
+	m.lockWaitForWait.Lock()
+	m.WaitForWaitFunc = fn
+	m.lockWaitForWait.Unlock()
+	return m
+}
+
+// MockClashSubscribeFunc is the func MockClash.Subscribe calls.
+type MockClashSubscribeFunc func(topic string)
+
+// MockClashSubscribeCall is a call made to MockClash.Subscribe.
+type MockClashSubscribeCall struct {
+	Topic string
+
+	// Panic is the value the call panicked with, if it did.
+	Panic interface{}
+}
+
+// Subscribe mocks base method by wrapping the associated func.
+func (m *MockClash) Subscribe(topic string) {
+	m.lockSubscribe.Lock()
+	fn := m.SubscribeFunc
+	if onCall, ok := m.onCalls.Subscribe[len(m.calls.Subscribe)]; ok {
+		fn = onCall
+		delete(m.onCalls.Subscribe, len(m.calls.Subscribe))
+	} else if len(m.queued.Subscribe) > 0 {
+		fn = m.queued.Subscribe[0]
+		m.queued.Subscribe = m.queued.Subscribe[1:]
+	}
+	if fn == nil {
+		if m.t == nil {
+			m.lockSubscribe.Unlock()
+			panic("mocker: MockClash.SubscribeFunc is nil but MockClash.Subscribe was called.")
+		}
+		m.unstubbed.Subscribe++
+	}
+	call := &MockClashSubscribeCall{
+		Topic: topic,
+	}
+	m.calls.Subscribe = append(m.calls.Subscribe, call)
+	m.seqs.Subscribe = append(m.seqs.Subscribe, mocker.Sequence())
+	if m.conds.Subscribe != nil {
+		m.conds.Subscribe.Broadcast()
+	}
+	m.lockSubscribe.Unlock()
+
+	defer func() {
+		r := recover()
+		m.lockSubscribe.Lock()
+		call.Panic = r
+		m.lockSubscribe.Unlock()
+		if r != nil {
+			panic(r)
+		}
+	}()
+
+	if fn == nil {
+		return
+	}
+
+	fn(topic)
+}
+
+// SubscribeCalled returns true if Subscribe was called at least once.
+func (m *MockClash) SubscribeCalled() bool {
+	m.lockSubscribe.Lock()
+	defer m.lockSubscribe.Unlock()
+
+	return len(m.calls.Subscribe) > 0
+}
+
+// SubscribeCalls returns the calls made to Subscribe.
+func (m *MockClash) SubscribeCalls() []MockClashSubscribeCall {
+	m.lockSubscribe.Lock()
+	defer m.lockSubscribe.Unlock()
+
+	var calls []MockClashSubscribeCall
+	for _, call := range m.calls.Subscribe {
+		calls = append(calls, *call)
+	}
+	return calls
+}
+
+// SubscribeCallCount returns the number of calls made to Subscribe.
+func (m *MockClash) SubscribeCallCount() int {
+	m.lockSubscribe.Lock()
+	defer m.lockSubscribe.Unlock()
+
+	return len(m.calls.Subscribe)
+}
+
+// SubscribeCallAt returns the i'th call made to Subscribe, counting from 0, failing the
+// test through t if there weren't that many calls.
+func (m *MockClash) SubscribeCallAt(t testing.TB, i int) MockClashSubscribeCall {
+	t.Helper()
+	m.lockSubscribe.Lock()
+	defer m.lockSubscribe.Unlock()
+
+	if i < 0 || i >= len(m.calls.Subscribe) {
+		t.Fatalf("mocker: MockClash.Subscribe was called %v times, wanted call %v", len(m.calls.Subscribe), i)
+	}
+	return *m.calls.Subscribe[i]
+}
+
+// SubscribeLastCall returns the last call made to Subscribe, failing the test through t
+// if there weren't any.
+func (m *MockClash) SubscribeLastCall(t testing.TB) MockClashSubscribeCall {
+	t.Helper()
+	m.lockSubscribe.Lock()
+	defer m.lockSubscribe.Unlock()
+
+	if len(m.calls.Subscribe) == 0 {
+		t.Fatalf("mocker: MockClash.Subscribe wasn't called")
+	}
+	return *m.calls.Subscribe[len(m.calls.Subscribe)-1]
+}
+
+// SubscribeCallsWhere returns the calls made to Subscribe that fn returns true for.
+func (m *MockClash) SubscribeCallsWhere(fn func(MockClashSubscribeCall) bool) []MockClashSubscribeCall {
+	var calls []MockClashSubscribeCall
+	for _, call := range m.SubscribeCalls() {
+		if fn(call) {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// AssertSubscribeCalledWith reports through t unless Subscribe was called with args
+// matching the matchers, and returns whether it was.
+func (m *MockClash) AssertSubscribeCalledWith(t testing.TB, topic match.Matcher) bool {
+	t.Helper()
+	m.lockSubscribe.Lock()
+	defer m.lockSubscribe.Unlock()
+
+	for _, call := range m.calls.Subscribe {
+		if topic.Match(call.Topic) {
+			return true
+		}
+	}
+	t.Errorf("mocker: MockClash.Subscribe wasn't called with (%v)", topic)
+	return false
+}
+
+// SubscribeCall describes the calls made to Subscribe with args matching args, which
+// may be match.Matchers, for asserting on their order with mocker.InOrder.
+func (m *MockClash) SubscribeCall(topic interface{}) mocker.Call {
+	m.lockSubscribe.Lock()
+	defer m.lockSubscribe.Unlock()
+
+	var seqs []uint64
+	for i, call := range m.calls.Subscribe {
+		if match.Of(topic).Match(call.Topic) {
+			seqs = append(seqs, m.seqs.Subscribe[i])
+		}
+	}
+	return mocker.Call{
+		Desc: mocker.Describe("MockClash.Subscribe", topic),
+		Seqs: seqs,
+	}
+}
+
+// WaitForSubscribe blocks until Subscribe has been called at least n times, returning
+// nil, or until ctx is done, returning its error.
+func (m *MockClash) WaitForSubscribe(ctx context.Context, n int) error {
+	m.lockSubscribe.Lock()
+	defer m.lockSubscribe.Unlock()
+
+	if m.conds.Subscribe == nil {
+		m.conds.Subscribe = sync.NewCond(&m.lockSubscribe)
+	}
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		select {
+		case <-ctx.Done():
+			m.lockSubscribe.Lock()
+			m.conds.Subscribe.Broadcast()
+			m.lockSubscribe.Unlock()
+		case <-stop:
+		}
+	}()
+	for len(m.calls.Subscribe) < n {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		m.conds.Subscribe.Wait()
+	}
+	return nil
+}
+
+// ResetSubscribe resets the calls made to Subscribe. Stubs set with OnSubscribeCall for calls
+// yet to be made keep their calls, now counted from the reset.
+func (m *MockClash) ResetSubscribe() {
+	m.lockSubscribe.Lock()
+	defer m.lockSubscribe.Unlock()
+
+	if n := len(m.calls.Subscribe); n > 0 && m.onCalls.Subscribe != nil {
+		onCalls := make(map[int]MockClashSubscribeFunc, len(m.onCalls.Subscribe))
+		for i, fn := range m.onCalls.Subscribe {
+			if i >= n {
+				onCalls[i-n] = fn
+			}
+		}
+		m.onCalls.Subscribe = onCalls
+	}
+	m.calls.Subscribe = nil
+	m.seqs.Subscribe = nil
+	m.unstubbed.Subscribe = 0
+}
+
+// OnSubscribeCall makes the n'th call to Subscribe, counting from 0 like SubscribeCalls, call fn
+// rather than SubscribeFunc.
+func (m *MockClash) OnSubscribeCall(n int, fn MockClashSubscribeFunc) {
+	m.lockSubscribe.Lock()
+	defer m.lockSubscribe.Unlock()
+
+	if m.onCalls.Subscribe == nil {
+		m.onCalls.Subscribe = make(map[int]MockClashSubscribeFunc)
+	}
+	m.onCalls.Subscribe[n] = fn
+}
+
+// SubscribeReturnsSequence queues fns for the next calls to Subscribe to call in turn, one
+// per call, after any queued before, then falling back to SubscribeFunc. Calls
+// stubbed with OnSubscribeCall don't use up the queue.
+func (m *MockClash) SubscribeReturnsSequence(fns ...MockClashSubscribeFunc) {
+	m.lockSubscribe.Lock()
+	defer m.lockSubscribe.Unlock()
+
+	m.queued.Subscribe = append(m.queued.Subscribe, fns...)
+}
+
+// WithSubscribe sets SubscribeFunc to fn, taking the lock calls to Subscribe take so it's safe
+// once the mock's shared, and returns the mock.
+func (m *MockClash) WithSubscribe(fn MockClashSubscribeFunc) *MockClash {
+	m.lockSubscribe.Lock()
+	m.SubscribeFunc = fn
+	m.lockSubscribe.Unlock()
+	return m
+}
+
+// Reset resets the calls made to the mocked methods.
+func (m *MockClash) Reset() {
+	m.ResetVerify()
+	m.ResetClose()
+	m.ResetKeys()
+	m.ResetDo()
+	m.ResetDoCall()
+	m.ResetAll_2()
+	m.ResetStubs_2()
+	m.ResetFoo_2()
+	m.ResetResetFoo()
+	m.ResetWait()
+	m.ResetWaitForWait()
+	m.ResetSubscribe()
+}
+
+// ResetStubs clears the funcs and per-call stubs of the mocked methods.
+func (m *MockClash) ResetStubs() {
+	m.lockVerify.Lock()
+	m.VerifyFunc = nil
+	m.onCalls.Verify = nil
+	m.queued.Verify = nil
+	m.lockVerify.Unlock()
+	m.lockClose.Lock()
+	m.CloseFunc = nil
+	m.onCalls.Close = nil
+	m.queued.Close = nil
+	m.lockClose.Unlock()
+	m.lockKeys.Lock()
+	m.KeysFunc = nil
+	m.onCalls.Keys = nil
+	m.queued.Keys = nil
+	m.lockKeys.Unlock()
+	m.lockDo.Lock()
+	m.DoFunc = nil
+	m.onCalls.Do = nil
+	m.queued.Do = nil
+	m.lockDo.Unlock()
+	m.lockDoCall.Lock()
+	m.DoCallFunc = nil
+	m.onCalls.DoCall = nil
+	m.queued.DoCall = nil
+	m.lockDoCall.Unlock()
+	m.lockAll.Lock()
+	m.AllFunc = nil
+	m.onCalls.All = nil
+	m.queued.All = nil
+	m.lockAll.Unlock()
+	m.lockStubs.Lock()
+	m.StubsFunc = nil
+	m.onCalls.Stubs = nil
+	m.queued.Stubs = nil
+	m.lockStubs.Unlock()
+	m.lockFoo.Lock()
+	m.FooFunc = nil
+	m.onCalls.Foo = nil
+	m.queued.Foo = nil
+	m.lockFoo.Unlock()
+	m.lockResetFoo.Lock()
+	m.ResetFooFunc = nil
+	m.onCalls.ResetFoo = nil
+	m.queued.ResetFoo = nil
+	m.lockResetFoo.Unlock()
+	m.lockWait.Lock()
+	m.WaitFunc = nil
+	m.onCalls.Wait = nil
+	m.queued.Wait = nil
+	m.lockWait.Unlock()
+	m.lockWaitForWait.Lock()
+	m.WaitForWaitFunc = nil
+	m.onCalls.WaitForWait = nil
+	m.queued.WaitForWait = nil
+	m.lockWaitForWait.Unlock()
+	m.lockSubscribe.Lock()
+	m.SubscribeFunc = nil
+	m.onCalls.Subscribe = nil
+	m.queued.Subscribe = nil
+	m.lockSubscribe.Unlock()
+}
+
+// ResetAll resets the calls made to the mocked methods and clears their
+// funcs and per-call stubs.
+func (m *MockClash) ResetAll() {
+	m.Reset()
+	m.ResetStubs()
+}
+
+// Verify_2 reports through t any calls made to methods without a func, funcs
+// and per-call stubs that weren't called, and unmet expectations. It returns
+// whether there were none.
+func (m *MockClash) Verify_2(t testing.TB) bool {
+	t.Helper()
+	ok := true
+	m.lockVerify.Lock()
+	if m.unstubbed.Verify > 0 {
+		t.Errorf("mocker: MockClash.Verify was called %v times without a func", m.unstubbed.Verify)
+		ok = false
+	}
+	if m.VerifyFunc != nil && len(m.calls.Verify) == 0 {
+		t.Errorf("mocker: MockClash.VerifyFunc is set but Verify wasn't called")
+		ok = false
+	}
+	for n := range m.onCalls.Verify {
+		t.Errorf("mocker: MockClash.Verify's stub for call %v wasn't called", n)
+		ok = false
+	}
+	if len(m.queued.Verify) > 0 {
+		t.Errorf("mocker: MockClash.Verify's last %v stubs in sequence weren't called", len(m.queued.Verify))
+		ok = false
+	}
+	m.lockVerify.Unlock()
+	m.lockClose.Lock()
+	if m.unstubbed.Close > 0 {
+		t.Errorf("mocker: MockClash.Close was called %v times without a func", m.unstubbed.Close)
+		ok = false
+	}
+	if m.CloseFunc != nil && len(m.calls.Close) == 0 {
+		t.Errorf("mocker: MockClash.CloseFunc is set but Close wasn't called")
+		ok = false
+	}
+	for n := range m.onCalls.Close {
+		t.Errorf("mocker: MockClash.Close's stub for call %v wasn't called", n)
+		ok = false
+	}
+	if len(m.queued.Close) > 0 {
+		t.Errorf("mocker: MockClash.Close's last %v stubs in sequence weren't called", len(m.queued.Close))
+		ok = false
+	}
+	m.lockClose.Unlock()
+	m.lockKeys.Lock()
+	if m.unstubbed.Keys > 0 {
+		t.Errorf("mocker: MockClash.Keys was called %v times without a func", m.unstubbed.Keys)
+		ok = false
+	}
+	if m.KeysFunc != nil && len(m.calls.Keys) == 0 {
+		t.Errorf("mocker: MockClash.KeysFunc is set but Keys wasn't called")
+		ok = false
+	}
+	for n := range m.onCalls.Keys {
+		t.Errorf("mocker: MockClash.Keys's stub for call %v wasn't called", n)
+		ok = false
+	}
+	if len(m.queued.Keys) > 0 {
+		t.Errorf("mocker: MockClash.Keys's last %v stubs in sequence weren't called", len(m.queued.Keys))
+		ok = false
+	}
+	m.lockKeys.Unlock()
+	m.lockDo.Lock()
+	if m.unstubbed.Do > 0 {
+		t.Errorf("mocker: MockClash.Do was called %v times without a func", m.unstubbed.Do)
+		ok = false
+	}
+	if m.DoFunc != nil && len(m.calls.Do) == 0 {
+		t.Errorf("mocker: MockClash.DoFunc is set but Do wasn't called")
+		ok = false
+	}
+	for n := range m.onCalls.Do {
+		t.Errorf("mocker: MockClash.Do's stub for call %v wasn't called", n)
+		ok = false
+	}
+	if len(m.queued.Do) > 0 {
+		t.Errorf("mocker: MockClash.Do's last %v stubs in sequence weren't called", len(m.queued.Do))
+		ok = false
+	}
+	m.lockDo.Unlock()
+	m.lockDoCall.Lock()
+	if m.unstubbed.DoCall > 0 {
+		t.Errorf("mocker: MockClash.DoCall was called %v times without a func", m.unstubbed.DoCall)
+		ok = false
+	}
+	if m.DoCallFunc != nil && len(m.calls.DoCall) == 0 {
+		t.Errorf("mocker: MockClash.DoCallFunc is set but DoCall wasn't called")
+		ok = false
+	}
+	for n := range m.onCalls.DoCall {
+		t.Errorf("mocker: MockClash.DoCall's stub for call %v wasn't called", n)
+		ok = false
+	}
+	if len(m.queued.DoCall) > 0 {
+		t.Errorf("mocker: MockClash.DoCall's last %v stubs in sequence weren't called", len(m.queued.DoCall))
+		ok = false
+	}
+	m.lockDoCall.Unlock()
+	m.lockAll.Lock()
+	if m.unstubbed.All > 0 {
+		t.Errorf("mocker: MockClash.All was called %v times without a func", m.unstubbed.All)
+		ok = false
+	}
+	if m.AllFunc != nil && len(m.calls.All) == 0 {
+		t.Errorf("mocker: MockClash.AllFunc is set but All wasn't called")
+		ok = false
+	}
+	for n := range m.onCalls.All {
+		t.Errorf("mocker: MockClash.All's stub for call %v wasn't called", n)
+		ok = false
+	}
+	if len(m.queued.All) > 0 {
+		t.Errorf("mocker: MockClash.All's last %v stubs in sequence weren't called", len(m.queued.All))
+		ok = false
+	}
+	m.lockAll.Unlock()
+	m.lockStubs.Lock()
+	if m.unstubbed.Stubs > 0 {
+		t.Errorf("mocker: MockClash.Stubs was called %v times without a func", m.unstubbed.Stubs)
+		ok = false
+	}
+	if m.StubsFunc != nil && len(m.calls.Stubs) == 0 {
+		t.Errorf("mocker: MockClash.StubsFunc is set but Stubs wasn't called")
+		ok = false
+	}
+	for n := range m.onCalls.Stubs {
+		t.Errorf("mocker: MockClash.Stubs's stub for call %v wasn't called", n)
+		ok = false
+	}
+	if len(m.queued.Stubs) > 0 {
+		t.Errorf("mocker: MockClash.Stubs's last %v stubs in sequence weren't called", len(m.queued.Stubs))
+		ok = false
+	}
+	m.lockStubs.Unlock()
+	m.lockFoo.Lock()
+	if m.unstubbed.Foo > 0 {
+		t.Errorf("mocker: MockClash.Foo was called %v times without a func", m.unstubbed.Foo)
+		ok = false
+	}
+	if m.FooFunc != nil && len(m.calls.Foo) == 0 {
+		t.Errorf("mocker: MockClash.FooFunc is set but Foo wasn't called")
+		ok = false
+	}
+	for n := range m.onCalls.Foo {
+		t.Errorf("mocker: MockClash.Foo's stub for call %v wasn't called", n)
+		ok = false
+	}
+	if len(m.queued.Foo) > 0 {
+		t.Errorf("mocker: MockClash.Foo's last %v stubs in sequence weren't called", len(m.queued.Foo))
+		ok = false
+	}
+	m.lockFoo.Unlock()
+	m.lockResetFoo.Lock()
+	if m.unstubbed.ResetFoo > 0 {
+		t.Errorf("mocker: MockClash.ResetFoo was called %v times without a func", m.unstubbed.ResetFoo)
+		ok = false
+	}
+	if m.ResetFooFunc != nil && len(m.calls.ResetFoo) == 0 {
+		t.Errorf("mocker: MockClash.ResetFooFunc is set but ResetFoo wasn't called")
+		ok = false
+	}
+	for n := range m.onCalls.ResetFoo {
+		t.Errorf("mocker: MockClash.ResetFoo's stub for call %v wasn't called", n)
+		ok = false
+	}
+	if len(m.queued.ResetFoo) > 0 {
+		t.Errorf("mocker: MockClash.ResetFoo's last %v stubs in sequence weren't called", len(m.queued.ResetFoo))
+		ok = false
+	}
+	m.lockResetFoo.Unlock()
+	m.lockWait.Lock()
+	if m.unstubbed.Wait > 0 {
+		t.Errorf("mocker: MockClash.Wait was called %v times without a func", m.unstubbed.Wait)
+		ok = false
+	}
+	if m.WaitFunc != nil && len(m.calls.Wait) == 0 {
+		t.Errorf("mocker: MockClash.WaitFunc is set but Wait wasn't called")
+		ok = false
+	}
+	for n := range m.onCalls.Wait {
+		t.Errorf("mocker: MockClash.Wait's stub for call %v wasn't called", n)
+		ok = false
+	}
+	if len(m.queued.Wait) > 0 {
+		t.Errorf("mocker: MockClash.Wait's last %v stubs in sequence weren't called", len(m.queued.Wait))
+		ok = false
+	}
+	m.lockWait.Unlock()
+	m.lockWaitForWait.Lock()
+	if m.unstubbed.WaitForWait > 0 {
+		t.Errorf("mocker: MockClash.WaitForWait was called %v times without a func", m.unstubbed.WaitForWait)
+		ok = false
+	}
+	if m.WaitForWaitFunc != nil && len(m.calls.WaitForWait) == 0 {
+		t.Errorf("mocker: MockClash.WaitForWaitFunc is set but WaitForWait wasn't called")
+		ok = false
+	}
+	for n := range m.onCalls.WaitForWait {
+		t.Errorf("mocker: MockClash.WaitForWait's stub for call %v wasn't called", n)
+		ok = false
+	}
+	if len(m.queued.WaitForWait) > 0 {
+		t.Errorf("mocker: MockClash.WaitForWait's last %v stubs in sequence weren't called", len(m.queued.WaitForWait))
+		ok = false
+	}
+	m.lockWaitForWait.Unlock()
+	m.lockSubscribe.Lock()
+	if m.unstubbed.Subscribe > 0 {
+		t.Errorf("mocker: MockClash.Subscribe was called %v times without a func", m.unstubbed.Subscribe)
+		ok = false
+	}
+	if m.SubscribeFunc != nil && len(m.calls.Subscribe) == 0 {
+		t.Errorf("mocker: MockClash.SubscribeFunc is set but Subscribe wasn't called")
+		ok = false
+	}
+	for n := range m.onCalls.Subscribe {
+		t.Errorf("mocker: MockClash.Subscribe's stub for call %v wasn't called", n)
+		ok = false
+	}
+	if len(m.queued.Subscribe) > 0 {
+		t.Errorf("mocker: MockClash.Subscribe's last %v stubs in sequence weren't called", len(m.queued.Subscribe))
+		ok = false
+	}
+	m.lockSubscribe.Unlock()
+	return ok
 }
@@ -0,0 +1,11 @@
+package self
+
+// Matcher is mocked into this package, which declares a name the mock's
+// imports would otherwise take.
+type Matcher interface {
+	Match(s string) bool
+}
+
+func match(s string) bool {
+	return s != ""
+}
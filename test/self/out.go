@@ -0,0 +1,342 @@
+// Code generated by mocker. DO NOT EDIT.
+// github.com/travisjeffery/mocker
+// Source: test/self/in.go
+
+package self
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/travisjeffery/mocker"
+	match_2 "github.com/travisjeffery/mocker/pkg/mocker/match"
+)
+
+// MockMatcher is a mock of Matcher interface
+type MockMatcher struct {
+	lockMatch sync.Mutex
+	MatchFunc MockMatcherMatchFunc
+
+	calls struct {
+		Match []*MockMatcherMatchCall
+	}
+	seqs struct {
+		Match []uint64
+	}
+	conds struct {
+		Match *sync.Cond
+	}
+	onCalls struct {
+		Match map[int]MockMatcherMatchFunc
+	}
+	queued struct {
+		Match []MockMatcherMatchFunc
+	}
+	unstubbed struct {
+		Match int
+	}
+	t testing.TB
+}
+
+// MockMatcher must implement Matcher, so the build breaks if it's stale.
+var _ Matcher = (*MockMatcher)(nil)
+
+// NewMockMatcher returns a MockMatcher that reports calls to methods without a
+// func through t, rather than panicking, and verifies it was used as
+// stubbed when the test's done.
+func NewMockMatcher(t testing.TB) *MockMatcher {
+	m := &MockMatcher{t: t}
+	t.Cleanup(func() {
+		m.Verify(t)
+	})
+	return m
+}
+
+// MockMatcherMatchFunc is the func MockMatcher.Match calls.
+type MockMatcherMatchFunc func(s string) bool
+
+// MockMatcherMatchCall is a call made to MockMatcher.Match.
+type MockMatcherMatchCall struct {
+	S string
+
+	Ret0 bool
+
+	// Panic is the value the call panicked with, if it did.
+	Panic interface{}
+}
+
+// Match mocks base method by wrapping the associated func.
+func (m *MockMatcher) Match(s string) bool {
+	m.lockMatch.Lock()
+	fn := m.MatchFunc
+	if onCall, ok := m.onCalls.Match[len(m.calls.Match)]; ok {
+		fn = onCall
+		delete(m.onCalls.Match, len(m.calls.Match))
+	} else if len(m.queued.Match) > 0 {
+		fn = m.queued.Match[0]
+		m.queued.Match = m.queued.Match[1:]
+	}
+	if fn == nil {
+		if m.t == nil {
+			m.lockMatch.Unlock()
+			panic("mocker: MockMatcher.MatchFunc is nil but MockMatcher.Match was called.")
+		}
+		m.unstubbed.Match++
+	}
+	call := &MockMatcherMatchCall{
+		S: s,
+	}
+	m.calls.Match = append(m.calls.Match, call)
+	m.seqs.Match = append(m.seqs.Match, mocker.Sequence())
+	if m.conds.Match != nil {
+		m.conds.Match.Broadcast()
+	}
+	m.lockMatch.Unlock()
+
+	var ret0 bool
+	defer func() {
+		r := recover()
+		m.lockMatch.Lock()
+		call.Ret0 = ret0
+		call.Panic = r
+		m.lockMatch.Unlock()
+		if r != nil {
+			panic(r)
+		}
+	}()
+
+	if fn == nil {
+		return ret0
+	}
+
+	ret0 = fn(s)
+	return ret0
+}
+
+// MatchCalled returns true if Match was called at least once.
+func (m *MockMatcher) MatchCalled() bool {
+	m.lockMatch.Lock()
+	defer m.lockMatch.Unlock()
+
+	return len(m.calls.Match) > 0
+}
+
+// MatchCalls returns the calls made to Match.
+func (m *MockMatcher) MatchCalls() []MockMatcherMatchCall {
+	m.lockMatch.Lock()
+	defer m.lockMatch.Unlock()
+
+	var calls []MockMatcherMatchCall
+	for _, call := range m.calls.Match {
+		calls = append(calls, *call)
+	}
+	return calls
+}
+
+// MatchCallCount returns the number of calls made to Match.
+func (m *MockMatcher) MatchCallCount() int {
+	m.lockMatch.Lock()
+	defer m.lockMatch.Unlock()
+
+	return len(m.calls.Match)
+}
+
+// MatchCallAt returns the i'th call made to Match, counting from 0, failing the
+// test through t if there weren't that many calls.
+func (m *MockMatcher) MatchCallAt(t testing.TB, i int) MockMatcherMatchCall {
+	t.Helper()
+	m.lockMatch.Lock()
+	defer m.lockMatch.Unlock()
+
+	if i < 0 || i >= len(m.calls.Match) {
+		t.Fatalf("mocker: MockMatcher.Match was called %v times, wanted call %v", len(m.calls.Match), i)
+	}
+	return *m.calls.Match[i]
+}
+
+// MatchLastCall returns the last call made to Match, failing the test through t
+// if there weren't any.
+func (m *MockMatcher) MatchLastCall(t testing.TB) MockMatcherMatchCall {
+	t.Helper()
+	m.lockMatch.Lock()
+	defer m.lockMatch.Unlock()
+
+	if len(m.calls.Match) == 0 {
+		t.Fatalf("mocker: MockMatcher.Match wasn't called")
+	}
+	return *m.calls.Match[len(m.calls.Match)-1]
+}
+
+// MatchCallsWhere returns the calls made to Match that fn returns true for.
+func (m *MockMatcher) MatchCallsWhere(fn func(MockMatcherMatchCall) bool) []MockMatcherMatchCall {
+	var calls []MockMatcherMatchCall
+	for _, call := range m.MatchCalls() {
+		if fn(call) {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// AssertMatchCalledWith reports through t unless Match was called with args
+// matching the matchers, and returns whether it was.
+func (m *MockMatcher) AssertMatchCalledWith(t testing.TB, s match_2.Matcher) bool {
+	t.Helper()
+	m.lockMatch.Lock()
+	defer m.lockMatch.Unlock()
+
+	for _, call := range m.calls.Match {
+		if s.Match(call.S) {
+			return true
+		}
+	}
+	t.Errorf("mocker: MockMatcher.Match wasn't called with (%v)", s)
+	return false
+}
+
+// MatchCall describes the calls made to Match with args matching args, which
+// may be match.Matchers, for asserting on their order with mocker.InOrder.
+func (m *MockMatcher) MatchCall(s interface{}) mocker.Call {
+	m.lockMatch.Lock()
+	defer m.lockMatch.Unlock()
+
+	var seqs []uint64
+	for i, call := range m.calls.Match {
+		if match_2.Of(s).Match(call.S) {
+			seqs = append(seqs, m.seqs.Match[i])
+		}
+	}
+	return mocker.Call{
+		Desc: mocker.Describe("MockMatcher.Match", s),
+		Seqs: seqs,
+	}
+}
+
+// WaitForMatch blocks until Match has been called at least n times, returning
+// nil, or until ctx is done, returning its error.
+func (m *MockMatcher) WaitForMatch(ctx context.Context, n int) error {
+	m.lockMatch.Lock()
+	defer m.lockMatch.Unlock()
+
+	if m.conds.Match == nil {
+		m.conds.Match = sync.NewCond(&m.lockMatch)
+	}
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		select {
+		case <-ctx.Done():
+			m.lockMatch.Lock()
+			m.conds.Match.Broadcast()
+			m.lockMatch.Unlock()
+		case <-stop:
+		}
+	}()
+	for len(m.calls.Match) < n {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		m.conds.Match.Wait()
+	}
+	return nil
+}
+
+// ResetMatch resets the calls made to Match. Stubs set with OnMatchCall for calls
+// yet to be made keep their calls, now counted from the reset.
+func (m *MockMatcher) ResetMatch() {
+	m.lockMatch.Lock()
+	defer m.lockMatch.Unlock()
+
+	if n := len(m.calls.Match); n > 0 && m.onCalls.Match != nil {
+		onCalls := make(map[int]MockMatcherMatchFunc, len(m.onCalls.Match))
+		for i, fn := range m.onCalls.Match {
+			if i >= n {
+				onCalls[i-n] = fn
+			}
+		}
+		m.onCalls.Match = onCalls
+	}
+	m.calls.Match = nil
+	m.seqs.Match = nil
+	m.unstubbed.Match = 0
+}
+
+// OnMatchCall makes the n'th call to Match, counting from 0 like MatchCalls, call fn
+// rather than MatchFunc.
+func (m *MockMatcher) OnMatchCall(n int, fn MockMatcherMatchFunc) {
+	m.lockMatch.Lock()
+	defer m.lockMatch.Unlock()
+
+	if m.onCalls.Match == nil {
+		m.onCalls.Match = make(map[int]MockMatcherMatchFunc)
+	}
+	m.onCalls.Match[n] = fn
+}
+
+// MatchReturnsSequence queues fns for the next calls to Match to call in turn, one
+// per call, after any queued before, then falling back to MatchFunc. Calls
+// stubbed with OnMatchCall don't use up the queue.
+func (m *MockMatcher) MatchReturnsSequence(fns ...MockMatcherMatchFunc) {
+	m.lockMatch.Lock()
+	defer m.lockMatch.Unlock()
+
+	m.queued.Match = append(m.queued.Match, fns...)
+}
+
+// WithMatch sets MatchFunc to fn, taking the lock calls to Match take so it's safe
+// once the mock's shared, and returns the mock.
+func (m *MockMatcher) WithMatch(fn MockMatcherMatchFunc) *MockMatcher {
+	m.lockMatch.Lock()
+	m.MatchFunc = fn
+	m.lockMatch.Unlock()
+	return m
+}
+
+// Reset resets the calls made to the mocked methods.
+func (m *MockMatcher) Reset() {
+	m.ResetMatch()
+}
+
+// ResetStubs clears the funcs and per-call stubs of the mocked methods.
+func (m *MockMatcher) ResetStubs() {
+	m.lockMatch.Lock()
+	m.MatchFunc = nil
+	m.onCalls.Match = nil
+	m.queued.Match = nil
+	m.lockMatch.Unlock()
+}
+
+// ResetAll resets the calls made to the mocked methods and clears their
+// funcs and per-call stubs.
+func (m *MockMatcher) ResetAll() {
+	m.Reset()
+	m.ResetStubs()
+}
+
+// Verify reports through t any calls made to methods without a func, funcs
+// and per-call stubs that weren't called, and unmet expectations. It returns
+// whether there were none.
+func (m *MockMatcher) Verify(t testing.TB) bool {
+	t.Helper()
+	ok := true
+	m.lockMatch.Lock()
+	if m.unstubbed.Match > 0 {
+		t.Errorf("mocker: MockMatcher.Match was called %v times without a func", m.unstubbed.Match)
+		ok = false
+	}
+	if m.MatchFunc != nil && len(m.calls.Match) == 0 {
+		t.Errorf("mocker: MockMatcher.MatchFunc is set but Match wasn't called")
+		ok = false
+	}
+	for n := range m.onCalls.Match {
+		t.Errorf("mocker: MockMatcher.Match's stub for call %v wasn't called", n)
+		ok = false
+	}
+	if len(m.queued.Match) > 0 {
+		t.Errorf("mocker: MockMatcher.Match's last %v stubs in sequence weren't called", len(m.queued.Match))
+		ok = false
+	}
+	m.lockMatch.Unlock()
+	return ok
+}
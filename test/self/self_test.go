@@ -0,0 +1,13 @@
+package self
+
+import "testing"
+
+func TestMatcher(t *testing.T) {
+	m := NewMockMatcher(t).WithMatch(match)
+	if !m.Match("a") {
+		t.Error("match = false, want true")
+	}
+	if !m.MatchCalled() {
+		t.Error("match called = false, want true")
+	}
+}
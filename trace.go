@@ -0,0 +1,40 @@
+// Package mocker is the runtime support for mocks generated by mocker.
+//
+// Generated mocks call into it; tests rarely need to use it directly.
+package mocker
+
+import (
+	"bytes"
+	"runtime"
+	"strconv"
+)
+
+// Caller returns the file:line of the code that called the mocked method
+// calling Caller, or an empty string if it can't be determined.
+func Caller() string {
+	// Skip Caller itself and the mocked method.
+	_, file, line, ok := runtime.Caller(2)
+	if !ok {
+		return ""
+	}
+	return file + ":" + strconv.Itoa(line)
+}
+
+// Goroutine returns the ID of the calling goroutine, or 0 if it can't be
+// determined. The runtime doesn't expose the ID so it's parsed from the
+// goroutine's stack trace, which is fine for tests but too slow for anything
+// else.
+func Goroutine() uint64 {
+	buf := make([]byte, 64)
+	buf = buf[:runtime.Stack(buf, false)]
+	// The trace starts with "goroutine 18 [running]:".
+	buf = bytes.TrimPrefix(buf, []byte("goroutine "))
+	if i := bytes.IndexByte(buf, ' '); i >= 0 {
+		buf = buf[:i]
+	}
+	id, err := strconv.ParseUint(string(buf), 10, 64)
+	if err != nil {
+		return 0
+	}
+	return id
+}